@@ -0,0 +1,64 @@
+package ezarr
+
+// The bisect functions search a *List that is already sorted, like Python's
+// bisect module, ordering elements with Compare as List.Sort does. When key
+// is not nil the list is searched by key(element), while x is compared as
+// given; Insort applies key to the element it inserts.
+
+func BisectLeft(l *List, x interface{}, key func(interface{}) interface{}) (int, error) {
+	return bisect(len(l.Elements), listKeyAt(l, key), x, false)
+}
+
+func BisectRight(l *List, x interface{}, key func(interface{}) interface{}) (int, error) {
+	return bisect(len(l.Elements), listKeyAt(l, key), x, true)
+}
+
+// Insort inserts element after any equal elements, keeping l sorted.
+func Insort(l *List, element interface{}, key func(interface{}) interface{}) error {
+	return insort(l, element, key, true)
+}
+
+// InsortLeft inserts element before any equal elements, keeping l sorted.
+func InsortLeft(l *List, element interface{}, key func(interface{}) interface{}) error {
+	return insort(l, element, key, false)
+}
+
+func insort(l *List, element interface{}, key func(interface{}) interface{}, right bool) error {
+	x := element
+	if key != nil {
+		x = key(element)
+	}
+	i, err := bisect(len(l.Elements), listKeyAt(l, key), x, right)
+	if err != nil {
+		return err
+	}
+	l.Insert(i, element)
+	return nil
+}
+
+func listKeyAt(l *List, key func(interface{}) interface{}) func(int) interface{} {
+	if key == nil {
+		return func(i int) interface{} { return l.Elements[i] }
+	}
+	return func(i int) interface{} { return key(l.Elements[i]) }
+}
+
+// bisect returns the position at which x would be inserted among the n
+// sorted values returned by at: before any equal values, or after them
+// when right is true.
+func bisect(n int, at func(int) interface{}, x interface{}, right bool) (int, error) {
+	lo, hi := 0, n
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		c, err := Compare(at(mid), x)
+		if err != nil {
+			return 0, err
+		}
+		if c < 0 || right && c == 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
@@ -0,0 +1,65 @@
+package ezarr
+
+import (
+	"errors"
+	"testing"
+)
+
+// Test | BisectLeft and BisectRight verify insertion points against Python results
+func TestBisect(t *testing.T) {
+	l := New(1, 2, 2, 2, 5, 8)
+
+	cases := []struct {
+		x           interface{}
+		left, right int
+	}{
+		{0, 0, 0},
+		{2, 1, 4},
+		{3, 4, 4},
+		{2.0, 1, 4},
+		{8, 5, 6},
+		{9, 6, 6},
+	}
+	for _, c := range cases {
+		left, err := BisectLeft(l, c.x, nil)
+		if err != nil || left != c.left {
+			t.Errorf("BisectLeft(%v): expected %d, got %d, error: %v", c.x, c.left, left, err)
+		}
+		right, err := BisectRight(l, c.x, nil)
+		if err != nil || right != c.right {
+			t.Errorf("BisectRight(%v): expected %d, got %d, error: %v", c.x, c.right, right, err)
+		}
+	}
+
+	if i, err := BisectLeft(New(), 1, nil); err != nil || i != 0 {
+		t.Errorf("Expected 0 for an empty list, got %d, error: %v", i, err)
+	}
+	if _, err := BisectLeft(l, "a", nil); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError for an incomparable value, got %v", err)
+	}
+}
+
+// Test | Insort verifies that lists stay sorted, with and without a key
+func TestInsort(t *testing.T) {
+	l := New()
+	for _, v := range []interface{}{5, 1, 4, 1, 3} {
+		if err := Insort(l, v, nil); err != nil {
+			t.Fatalf("Insort returned error: %v", err)
+		}
+	}
+	if !Equal(l, New(1, 1, 3, 4, 5)) {
+		t.Errorf("Expected [1, 1, 3, 4, 5], got %v", l)
+	}
+
+	second := func(e interface{}) interface{} { v, _ := e.(Tuple).Get(1); return v }
+	people := New(NewTuple("ann", 30), NewTuple("bob", 40))
+	Insort(people, NewTuple("cat", 30), second)
+	InsortLeft(people, NewTuple("dan", 30), second)
+	if Repr(people) != "[('dan', 30), ('ann', 30), ('cat', 30), ('bob', 40)]" {
+		t.Errorf("Unexpected order: %s", Repr(people))
+	}
+
+	if i, _ := BisectRight(people, 30, second); i != 3 {
+		t.Errorf("Expected x to be compared with the keys as given, got %d", i)
+	}
+}
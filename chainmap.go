@@ -0,0 +1,143 @@
+package ezarr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ChainMap groups several Dicts into a single view, like Python's
+// collections.ChainMap. Lookups search the Dicts in order, while writes and
+// deletions only change the first one. The Dicts are not copied, so changes
+// made to them directly are visible through the ChainMap.
+type ChainMap struct {
+	Maps []*Dict
+}
+
+func NewChainMap(maps ...*Dict) *ChainMap {
+	if len(maps) == 0 {
+		return &ChainMap{Maps: []*Dict{{}}}
+	}
+	return &ChainMap{Maps: append([]*Dict{}, maps...)}
+}
+
+func (c *ChainMap) Get(key interface{}) (interface{}, error) {
+	for _, m := range c.Maps {
+		if index := m.findIndex(key); index != -1 {
+			return m.Values[index], nil
+		}
+	}
+	return nil, keyNotFound(key)
+}
+
+func (c *ChainMap) GetDefault(key, defaultValue interface{}) interface{} {
+	if value, err := c.Get(key); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+func (c *ChainMap) Contains(key interface{}) bool {
+	for _, m := range c.Maps {
+		if m.Contains(key) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ChainMap) Set(key, value interface{}) *ChainMap {
+	c.first().Set(key, value)
+	return c
+}
+
+func (c *ChainMap) Delete(key interface{}) error {
+	if err := c.first().Delete(key); err != nil {
+		return firstMappingKeyError(key)
+	}
+	return nil
+}
+
+func (c *ChainMap) Pop(key interface{}) (interface{}, error) {
+	value, err := c.first().Pop(key)
+	if err != nil {
+		return nil, firstMappingKeyError(key)
+	}
+	return value, nil
+}
+
+// Clear empties the first Dict only.
+func (c *ChainMap) Clear() *ChainMap {
+	c.first().Clear()
+	return c
+}
+
+// NewChild returns a ChainMap with d in front of the Dicts of c. A nil d
+// is replaced by a new empty Dict.
+func (c *ChainMap) NewChild(d *Dict) *ChainMap {
+	if d == nil {
+		d = &Dict{}
+	}
+	return &ChainMap{Maps: append([]*Dict{d}, c.Maps...)}
+}
+
+// Parents returns a ChainMap of every Dict but the first.
+func (c *ChainMap) Parents() *ChainMap {
+	if len(c.Maps) <= 1 {
+		return NewChainMap()
+	}
+	return NewChainMap(c.Maps[1:]...)
+}
+
+// GetKeys returns every key once. As in Python, keys are ordered by their
+// first appearance starting from the last Dict.
+func (c *ChainMap) GetKeys() *List {
+	return c.ToDict().GetKeys()
+}
+
+func (c *ChainMap) GetValues() *List {
+	return c.ToDict().GetValues()
+}
+
+func (c *ChainMap) GetItems() *List {
+	return c.ToDict().GetItems()
+}
+
+func (c *ChainMap) Len() int {
+	return c.ToDict().Len()
+}
+
+// ToDict flattens the ChainMap into a new Dict holding the value each key
+// resolves to.
+func (c *ChainMap) ToDict() *Dict {
+	result := &Dict{Keys: []interface{}{}, Values: []interface{}{}}
+	for i := len(c.Maps) - 1; i >= 0; i-- {
+		result.Update(c.Maps[i])
+	}
+	return result
+}
+
+func (c *ChainMap) String() string {
+	if reprStrings.Load() {
+		return Repr(c)
+	}
+	maps := make([]string, len(c.Maps))
+	for i, m := range c.Maps {
+		maps[i] = fmt.Sprintf("%v", m)
+	}
+	return "ChainMap(" + strings.Join(maps, ", ") + ")"
+}
+
+func (c *ChainMap) Repr() string {
+	maps := make([]string, len(c.Maps))
+	for i, m := range c.Maps {
+		maps[i] = Repr(m)
+	}
+	return "ChainMap(" + strings.Join(maps, ", ") + ")"
+}
+
+func (c *ChainMap) first() *Dict {
+	if len(c.Maps) == 0 {
+		c.Maps = []*Dict{{}}
+	}
+	return c.Maps[0]
+}
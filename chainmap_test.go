@@ -0,0 +1,112 @@
+package ezarr
+
+import (
+	"errors"
+	"testing"
+)
+
+func configLayers() (*Dict, *Dict, *Dict) {
+	defaults, _ := NewDict("host", "localhost", "port", 80, "debug", false)
+	file, _ := NewDict("port", 8080, "user", "app")
+	flags, _ := NewDict("debug", true)
+	return defaults, file, flags
+}
+
+// Test | ChainMap verifies that lookups fall through the layers in order
+func TestChainMapLookup(t *testing.T) {
+	defaults, file, flags := configLayers()
+	config := NewChainMap(flags, file, defaults)
+
+	cases := map[string]interface{}{"host": "localhost", "port": 8080, "debug": true, "user": "app"}
+	for key, expected := range cases {
+		if val, err := config.Get(key); err != nil || val != expected {
+			t.Errorf("Expected %v for %s, got %v, error: %v", expected, key, val, err)
+		}
+	}
+
+	if _, err := config.Get("missing"); !errors.Is(err, ErrKey) {
+		t.Errorf("Expected KeyError for a missing key, got %v", err)
+	}
+	if config.GetDefault("missing", 1) != 1 || config.Contains("missing") || !config.Contains("host") {
+		t.Error("Unexpected GetDefault or Contains result")
+	}
+
+	defaults.Set("timeout", 30)
+	if val, _ := config.Get("timeout"); val != 30 {
+		t.Errorf("Expected changes to a layer to be visible, got %v", val)
+	}
+}
+
+// Test | ChainMap verifies that writes and deletes only touch the first layer
+func TestChainMapWrites(t *testing.T) {
+	defaults, file, flags := configLayers()
+	config := NewChainMap(flags, file, defaults)
+
+	config.Set("port", 9000)
+	if flags.GetDefault("port", nil) != 9000 || file.GetDefault("port", nil) != 8080 {
+		t.Errorf("Expected Set to write to the first layer only, got %v and %v", flags, file)
+	}
+
+	if err := config.Delete("port"); err != nil {
+		t.Errorf("Delete returned error: %v", err)
+	}
+	if val, _ := config.Get("port"); val != 8080 {
+		t.Errorf("Expected the lower layer to show through after Delete, got %v", val)
+	}
+
+	err := config.Delete("host")
+	var keyErr *KeyError
+	if !errors.As(err, &keyErr) || keyErr.Key != "host" || !defaults.Contains("host") {
+		t.Errorf("Expected KeyError when deleting a key from a lower layer, got %v", err)
+	}
+	if _, err := config.Pop("user"); !errors.Is(err, ErrKey) {
+		t.Errorf("Expected KeyError popping a key from a lower layer, got %v", err)
+	}
+	if val, err := config.Pop("debug"); err != nil || val != true {
+		t.Errorf("Expected to pop debug from the first layer, got %v, error: %v", val, err)
+	}
+
+	empty := &ChainMap{}
+	empty.Set("a", 1)
+	if len(empty.Maps) != 1 || !empty.Contains("a") {
+		t.Errorf("Expected the zero ChainMap to create its first layer, got %v", empty)
+	}
+}
+
+// Test | ChainMap verifies NewChild, Parents and key order against Python results
+func TestChainMapLayers(t *testing.T) {
+	defaults, file, flags := configLayers()
+	config := NewChainMap(file, defaults)
+
+	child := config.NewChild(flags)
+	if len(child.Maps) != 3 || child.Maps[0] != flags || len(config.Maps) != 2 {
+		t.Errorf("Expected NewChild to prepend a layer without changing the parent, got %v", child)
+	}
+	scratch := config.NewChild(nil)
+	scratch.Set("tmp", 1)
+	if config.Contains("tmp") || !scratch.Contains("tmp") {
+		t.Error("Expected NewChild(nil) to add a new empty layer")
+	}
+
+	parents := child.Parents()
+	if len(parents.Maps) != 2 || parents.Maps[0] != file {
+		t.Errorf("Expected Parents to drop the first layer, got %v", parents)
+	}
+	if root := NewChainMap(defaults).Parents(); len(root.Maps) != 1 || root.Maps[0].Len() != 0 {
+		t.Errorf("Expected Parents of a single layer to be one empty layer, got %v", root)
+	}
+
+	if Repr(child.GetKeys()) != "['host', 'port', 'debug', 'user']" {
+		t.Errorf("Unexpected key order: %s", Repr(child.GetKeys()))
+	}
+	if Repr(child.ToDict()) != "{'host': 'localhost', 'port': 8080, 'debug': True, 'user': 'app'}" {
+		t.Errorf("Unexpected flattened dict: %s", Repr(child.ToDict()))
+	}
+	if child.Len() != 4 || child.GetValues().Len() != 4 || child.GetItems().Len() != 4 {
+		t.Errorf("Expected 4 unique keys, got %d", child.Len())
+	}
+
+	if Repr(NewChainMap(flags, &Dict{})) != "ChainMap({'debug': True}, {})" {
+		t.Errorf("Unexpected repr: %s", Repr(NewChainMap(flags, &Dict{})))
+	}
+}
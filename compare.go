@@ -0,0 +1,328 @@
+package ezarr
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Comparable lets a type take part in Compare. CompareTo returns a negative
+// number, zero or a positive number when the receiver orders before, equal
+// to or after other, and an error when the two cannot be ordered.
+type Comparable interface {
+	CompareTo(other interface{}) (int, error)
+}
+
+var (
+	listType  = reflect.TypeOf((*List)(nil))
+	timeType  = reflect.TypeOf(time.Time{})
+	bytesType = reflect.TypeOf([]byte(nil))
+)
+
+// Compare orders a and b following Python's rules: numbers of any Go kind
+// compare by value, strings and byte slices compare lexicographically,
+// *List, Tuple and Go slices compare element by element, and anything else
+// is an error.
+func Compare(a, b interface{}) (int, error) {
+	switch x := a.(type) {
+	case int:
+		if y, ok := b.(int); ok {
+			switch {
+			case x < y:
+				return -1, nil
+			case x > y:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), nil
+		}
+	}
+
+	if ca, ok := a.(Comparable); ok {
+		if c, err := ca.CompareTo(b); err == nil {
+			return c, nil
+		}
+	}
+	if cb, ok := b.(Comparable); ok {
+		if c, err := cb.CompareTo(a); err == nil {
+			return -c, nil
+		}
+	}
+
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	ka, kb := compareKind(va), compareKind(vb)
+	if ka == kindNone || ka != kb {
+		return 0, compareError(a, b)
+	}
+
+	switch ka {
+	case kindNumber:
+		return compareNumbers(va, vb), nil
+	case kindString:
+		return strings.Compare(va.String(), vb.String()), nil
+	case kindBytes:
+		return bytes.Compare(va.Bytes(), vb.Bytes()), nil
+	case kindTime:
+		return va.Interface().(time.Time).Compare(vb.Interface().(time.Time)), nil
+	case kindList:
+		return compareSequences(a.(*List).Elements, b.(*List).Elements)
+	case kindSequence:
+		return compareSequences(sequenceElements(va), sequenceElements(vb))
+	}
+	return 0, compareError(a, b)
+}
+
+const (
+	kindNone = iota
+	kindNumber
+	kindString
+	kindBytes
+	kindTime
+	kindList
+	kindSequence
+)
+
+func compareKind(v reflect.Value) int {
+	if !v.IsValid() {
+		return kindNone
+	}
+	switch v.Type() {
+	case listType:
+		if v.IsNil() {
+			return kindNone
+		}
+		return kindList
+	case timeType:
+		return kindTime
+	case tupleType:
+		return kindSequence
+	}
+
+	switch v.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return kindNumber
+	case reflect.String:
+		return kindString
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return kindBytes
+		}
+		return kindSequence
+	case reflect.Array:
+		return kindSequence
+	}
+	return kindNone
+}
+
+func sequenceElements(v reflect.Value) []interface{} {
+	if v.Type() == tupleType {
+		return v.Interface().(Tuple).elements
+	}
+	elements := make([]interface{}, v.Len())
+	for i := range elements {
+		elements[i] = v.Index(i).Interface()
+	}
+	return elements
+}
+
+func compareSequences(a, b []interface{}) (int, error) {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if valuesEqual(a[i], b[i]) {
+			continue
+		}
+		return Compare(a[i], b[i])
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1, nil
+	case len(a) > len(b):
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Truth reports whether v is true in a boolean context, like Python's
+// bool(v): nil, false, zero numbers, empty strings, and empty slices, maps
+// and containers are false; everything else is true.
+func Truth(v interface{}) bool {
+	if l, ok := v.(interface{ Len() int }); ok {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return false
+		}
+		return l.Len() != 0
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return false
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int() != 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint() != 0
+	case reflect.Float32, reflect.Float64:
+		return rv.Float() != 0
+	case reflect.Complex64, reflect.Complex128:
+		return rv.Complex() != 0
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array, reflect.Chan:
+		return rv.Len() != 0
+	case reflect.Pointer, reflect.Interface, reflect.Func:
+		return !rv.IsNil()
+	}
+	return true
+}
+
+// valuesEqual is Python's == as used by sequence comparison: numbers of
+// different kinds are equal when their values are, and everything else
+// falls back to Equal.
+func valuesEqual(a, b interface{}) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	ka, kb := compareKind(va), compareKind(vb)
+	switch {
+	case ka == kindNumber && kb == kindNumber:
+		return compareNumbers(va, vb) == 0 && !isNaN(va) && !isNaN(vb)
+	case ka == kindList && kb == kindList:
+		return sequencesEqual(a.(*List).Elements, b.(*List).Elements)
+	case ka == kindSequence && kb == kindSequence:
+		return sequencesEqual(sequenceElements(va), sequenceElements(vb))
+	}
+	return Equal(a, b)
+}
+
+func sequencesEqual(a, b []interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !valuesEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isNaN(v reflect.Value) bool {
+	k := v.Kind()
+	return (k == reflect.Float32 || k == reflect.Float64) && math.IsNaN(v.Float())
+}
+
+// compareNumbers compares two numeric values exactly, without losing
+// precision between large integers and floats. NaN compares equal to
+// everything, which matches Python where every comparison with NaN is false.
+func compareNumbers(a, b reflect.Value) int {
+	if isNaN(a) || isNaN(b) {
+		return 0
+	}
+
+	fa, aFloat := numberFloat(a)
+	fb, bFloat := numberFloat(b)
+	if !aFloat && !bFloat {
+		return numberInt(a).Cmp(numberInt(b))
+	}
+	if aFloat && bFloat {
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		}
+		return 0
+	}
+
+	x, y := new(big.Float), new(big.Float)
+	if aFloat {
+		x.SetFloat64(fa)
+	} else {
+		x.SetInt(numberInt(a))
+	}
+	if bFloat {
+		y.SetFloat64(fb)
+	} else {
+		y.SetInt(numberInt(b))
+	}
+	return x.Cmp(y)
+}
+
+func numberFloat(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+func numberInt(v reflect.Value) *big.Int {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return big.NewInt(1)
+		}
+		return big.NewInt(0)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(v.Uint())
+	}
+	return big.NewInt(v.Int())
+}
+
+func compareError(a, b interface{}) error {
+	return &TypeError{
+		Value: a,
+		Msg:   fmt.Sprintf("'<' not supported between instances of '%s' and '%s'", pyTypeName(a), pyTypeName(b)),
+	}
+}
+
+// pyTypeName names the Python type that a Go value stands in for.
+func pyTypeName(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return "NoneType"
+	}
+	switch rv.Type() {
+	case listType:
+		return "list"
+	case reflect.TypeOf((*Dict)(nil)):
+		return "dict"
+	case timeType:
+		return "datetime"
+	case tupleType:
+		if typ := rv.Interface().(Tuple).typ; typ != nil {
+			return typ.name
+		}
+		return "tuple"
+	case bytesType:
+		return "bytes"
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return "int"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	case reflect.Complex64, reflect.Complex128:
+		return "complex"
+	case reflect.String:
+		return "str"
+	case reflect.Slice, reflect.Array:
+		return "tuple"
+	case reflect.Map:
+		return "dict"
+	}
+	return rv.Type().String()
+}
@@ -0,0 +1,180 @@
+package ezarr
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+type version struct {
+	major, minor int
+}
+
+func (v version) CompareTo(other interface{}) (int, error) {
+	o, ok := other.(version)
+	if !ok {
+		return 0, compareError(v, other)
+	}
+	if v.major != o.major {
+		return v.major - o.major, nil
+	}
+	return v.minor - o.minor, nil
+}
+
+// Test | Compare verifies Python ordering across the numeric tower
+func TestCompareNumbers(t *testing.T) {
+	cases := []struct {
+		a, b     interface{}
+		expected int
+	}{
+		{1, 2, -1},
+		{int64(5), uint8(5), 0},
+		{uint64(math.MaxUint64), int64(-1), 1},
+		{1, 1.5, -1},
+		{float32(2.5), 2.5, 0},
+		{int64(1 << 53), float64(1<<53) + 1, 0},
+		{int64(1<<53 + 1), float64(1 << 53), 1},
+		{true, 0, 1},
+		{math.Inf(-1), math.MinInt64, -1},
+		{time.Second, 2 * time.Second, -1},
+	}
+
+	for _, c := range cases {
+		got, err := Compare(c.a, c.b)
+		if err != nil {
+			t.Errorf("Compare(%v, %v) returned error: %v", c.a, c.b, err)
+			continue
+		}
+		if sign(got) != c.expected {
+			t.Errorf("Compare(%v, %v) = %d, expected %d", c.a, c.b, got, c.expected)
+		}
+	}
+}
+
+// Test | Compare verifies ordering of strings, times, sequences and Comparable types
+func TestCompareOther(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		a, b     interface{}
+		expected int
+	}{
+		{"apple", "banana", -1},
+		{[]byte("b"), []byte("a"), 1},
+		{now, now.Add(time.Hour), -1},
+		{New(1, 2), New(1, 2, 0), -1},
+		{New(1, "a"), New(1.0, "b"), -1},
+		{New(New(2)), New(New(1, 5)), 1},
+		{[]interface{}{"x", 1}, []interface{}{"x", 1}, 0},
+		{[2]int{1, 3}, [2]int{1, 2}, 1},
+		{New(map[string]int{"a": 1}, 1), New(map[string]int{"a": 1}, 2), -1},
+		{version{1, 2}, version{1, 10}, -1},
+	}
+
+	for _, c := range cases {
+		got, err := Compare(c.a, c.b)
+		if err != nil {
+			t.Errorf("Compare(%v, %v) returned error: %v", c.a, c.b, err)
+			continue
+		}
+		if sign(got) != c.expected {
+			t.Errorf("Compare(%v, %v) = %d, expected %d", c.a, c.b, got, c.expected)
+		}
+	}
+}
+
+// Test | Compare verifies that incomparable pairs report a TypeError-style message
+func TestCompareIncomparable(t *testing.T) {
+	cases := [][2]interface{}{
+		{1, "a"},
+		{nil, nil},
+		{1i, 2i},
+		{New(1), []interface{}{1}},
+		{New(1, "a"), New(1, 2)},
+		{map[string]int{}, map[string]int{}},
+	}
+
+	for _, c := range cases {
+		if _, err := Compare(c[0], c[1]); err == nil {
+			t.Errorf("Expected error comparing %v and %v, got nil", c[0], c[1])
+		}
+	}
+
+	_, err := Compare(1, "a")
+	expected := "'<' not supported between instances of 'int' and 'str'"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got %v", expected, err)
+	}
+}
+
+// Test | Sort verifies sorting mixed numeric kinds, nested lists and times
+func TestSortMixed(t *testing.T) {
+	list := New(3, 1.5, int64(-2), uint(7), float32(0.5), true)
+	if err := list.Sort(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []interface{}{int64(-2), float32(0.5), true, 1.5, 3, uint(7)}
+	for i, v := range expected {
+		if list.Elements[i] != v {
+			t.Errorf("Expected %v at index %d, got %v", v, i, list.Elements[i])
+		}
+	}
+
+	nested := New(New(2, 1), New(1, 9), New(1))
+	if err := nested.Sort(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if nested.String() != "[[1], [1, 9], [2, 1]]" {
+		t.Errorf("Expected [[1], [1, 9], [2, 1]], got %v", nested)
+	}
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	times := New(base.Add(time.Hour), base, base.Add(time.Minute))
+	if err := times.Sort(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if times.Elements[0] != base || times.Elements[2] != base.Add(time.Hour) {
+		t.Errorf("Expected times in ascending order, got %v", times)
+	}
+
+	stable := New(1.0, 1, int8(1))
+	stable.Sort()
+	if stable.Elements[0] != 1.0 || stable.Elements[1] != 1 || stable.Elements[2] != int8(1) {
+		t.Errorf("Expected equal elements to keep their order, got %#v", stable.Elements)
+	}
+
+	failing := New(2, "a", 1)
+	if err := failing.Sort(); err == nil {
+		t.Error("Expected error when sorting incomparable elements, got nil")
+	}
+	if failing.Elements[0] != 2 || failing.Elements[1] != "a" || failing.Elements[2] != 1 {
+		t.Errorf("Expected list to be unchanged after failed sort, got %v", failing)
+	}
+}
+
+func sign(x int) int {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return 1
+	}
+	return 0
+}
+
+// Test | Truth verifies Python truthiness for values and containers
+func TestTruth(t *testing.T) {
+	var nilList *List
+	falsy := []interface{}{nil, false, 0, 0.0, uint8(0), "", []int{}, map[string]int{}, New(), &Dict{}, NewTuple(), NewSet(), RangeTo(0), nilList}
+	truthy := []interface{}{true, 1, -0.5, math.NaN(), "a", []int{0}, New(nil), NewTuple(0), RangeTo(1), version{}, time.Time{}}
+
+	for _, v := range falsy {
+		if Truth(v) {
+			t.Errorf("Expected %#v to be false", v)
+		}
+	}
+	for _, v := range truthy {
+		if !Truth(v) {
+			t.Errorf("Expected %#v to be true", v)
+		}
+	}
+}
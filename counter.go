@@ -0,0 +1,277 @@
+package ezarr
+
+import "container/heap"
+
+// Counter counts hashable elements, like Python's collections.Counter.
+// Elements are matched with Equal, the same way Dict matches keys, and a
+// missing element has a count of zero. The zero value is an empty Counter.
+type Counter struct {
+	counts Dict
+}
+
+func NewCounter(elements ...interface{}) *Counter {
+	c := &Counter{}
+	for _, e := range elements {
+		c.Increment(e, 1)
+	}
+	return c
+}
+
+func CounterFromList(l *List) *Counter {
+	return NewCounter(l.Elements...)
+}
+
+func (c *Counter) Get(element interface{}) int {
+	if index, _ := c.counts.lookup(element); index != -1 {
+		return c.counts.Values[index].(int)
+	}
+	return 0
+}
+
+func (c *Counter) Set(element interface{}, count int) *Counter {
+	if index, h := c.counts.lookup(element); index != -1 {
+		c.counts.Values[index] = count
+	} else {
+		c.counts.insert(h, element, count)
+	}
+	return c
+}
+
+func (c *Counter) Increment(element interface{}, n int) *Counter {
+	index, h := c.counts.lookup(element)
+	if index != -1 {
+		c.counts.Values[index] = c.counts.Values[index].(int) + n
+		return c
+	}
+	c.counts.insert(h, element, n)
+	return c
+}
+
+func (c *Counter) Delete(element interface{}) error {
+	return c.counts.Delete(element)
+}
+
+func (c *Counter) Contains(element interface{}) bool {
+	index, _ := c.counts.lookup(element)
+	return index != -1
+}
+
+func (c *Counter) Len() int {
+	return c.counts.Len()
+}
+
+func (c *Counter) Clear() *Counter {
+	c.counts.Clear()
+	return c
+}
+
+func (c *Counter) Copy() *Counter {
+	return &Counter{counts: *c.ToDict()}
+}
+
+func (c *Counter) ToDict() *Dict {
+	d := &Dict{
+		Keys:   append([]interface{}{}, c.counts.Keys...),
+		Values: append([]interface{}{}, c.counts.Values...),
+	}
+	d.index.rebuild(d.Keys)
+	return d
+}
+
+func (c *Counter) Update(l *List) *Counter {
+	for _, e := range append([]interface{}{}, l.Elements...) {
+		c.Increment(e, 1)
+	}
+	return c
+}
+
+func (c *Counter) UpdateCounts(other *Counter) *Counter {
+	for i, e := range append([]interface{}{}, other.counts.Keys...) {
+		c.Increment(e, other.counts.Values[i].(int))
+	}
+	return c
+}
+
+// Subtract lowers the counts of the elements of l. Unlike Sub, counts may
+// drop to zero or below.
+func (c *Counter) Subtract(l *List) *Counter {
+	for _, e := range append([]interface{}{}, l.Elements...) {
+		c.Increment(e, -1)
+	}
+	return c
+}
+
+func (c *Counter) SubtractCounts(other *Counter) *Counter {
+	if other == c {
+		other = c.Copy()
+	}
+	for i, e := range other.counts.Keys {
+		c.Increment(e, -other.counts.Values[i].(int))
+	}
+	return c
+}
+
+func (c *Counter) Total() int {
+	total := 0
+	for _, count := range c.counts.Values {
+		total += count.(int)
+	}
+	return total
+}
+
+// Elements repeats each element as many times as its count, in insertion
+// order. Elements with a count below one are left out.
+func (c *Counter) Elements() *List {
+	elements := []interface{}{}
+	for i, e := range c.counts.Keys {
+		for n := c.counts.Values[i].(int); n > 0; n-- {
+			elements = append(elements, e)
+		}
+	}
+	return &List{Elements: elements}
+}
+
+// MostCommon returns the n most common elements and their counts as
+// (element, count) Tuples, from the most common to the least. Equal counts
+// keep insertion order. A negative n returns every element.
+func (c *Counter) MostCommon(n int) *List {
+	size := c.counts.Len()
+	if n < 0 || n > size {
+		n = size
+	}
+
+	h := &countHeap{counter: c}
+	for i := 0; i < size; i++ {
+		if h.Len() < n {
+			heap.Push(h, i)
+		} else if n > 0 && h.before(i, h.order[0]) {
+			h.order[0] = i
+			heap.Fix(h, 0)
+		}
+	}
+
+	items := make([]interface{}, h.Len())
+	for i := len(items) - 1; i >= 0; i-- {
+		index := heap.Pop(h).(int)
+		items[i] = NewTuple(c.counts.Keys[index], c.counts.Values[index])
+	}
+	return &List{Elements: items}
+}
+
+func (c *Counter) Add(other *Counter) *Counter {
+	return c.combine(other, func(a, b int) int { return a + b })
+}
+
+func (c *Counter) Sub(other *Counter) *Counter {
+	return c.combine(other, func(a, b int) int { return a - b })
+}
+
+func (c *Counter) And(other *Counter) *Counter {
+	return c.combine(other, func(a, b int) int {
+		if a < b {
+			return a
+		}
+		return b
+	})
+}
+
+func (c *Counter) Or(other *Counter) *Counter {
+	return c.combine(other, func(a, b int) int {
+		if a > b {
+			return a
+		}
+		return b
+	})
+}
+
+// Equal reports whether c and other hold the same counts, regardless of
+// order. Missing elements count as zero.
+func (c *Counter) Equal(other *Counter) bool {
+	for i, e := range c.counts.Keys {
+		if c.counts.Values[i].(int) != other.Get(e) {
+			return false
+		}
+	}
+	for i, e := range other.counts.Keys {
+		if other.counts.Values[i].(int) != c.Get(e) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Counter) String() string {
+	if reprStrings.Load() {
+		return Repr(c)
+	}
+	if c.counts.Len() == 0 {
+		return "Counter()"
+	}
+	return "Counter(" + c.mostCommonDict().String() + ")"
+}
+
+func (c *Counter) Repr() string {
+	return Repr(c)
+}
+
+func (c Counter) hashElements() ([]interface{}, bool) {
+	return c.counts.hashElements()
+}
+
+// combine applies op to the counts of every element in c or other and keeps
+// only the positive results, like Counter arithmetic in Python.
+func (c *Counter) combine(other *Counter, op func(a, b int) int) *Counter {
+	result := &Counter{}
+	for _, e := range c.counts.Keys {
+		if count := op(c.Get(e), other.Get(e)); count > 0 {
+			result.Set(e, count)
+		}
+	}
+	for _, e := range other.counts.Keys {
+		if c.Contains(e) {
+			continue
+		}
+		if count := op(0, other.Get(e)); count > 0 {
+			result.Set(e, count)
+		}
+	}
+	return result
+}
+
+func (c *Counter) mostCommonDict() *Dict {
+	d := &Dict{Keys: []interface{}{}, Values: []interface{}{}}
+	for _, item := range c.MostCommon(-1).Elements {
+		pair := item.(Tuple)
+		d.Keys = append(d.Keys, pair.elements[0])
+		d.Values = append(d.Values, pair.elements[1])
+	}
+	return d
+}
+
+// countHeap is a min-heap of positions in a Counter, ordered so that the
+// least common element, and among equal counts the latest inserted, is on
+// top.
+type countHeap struct {
+	counter *Counter
+	order   []int
+}
+
+func (h *countHeap) before(i, j int) bool {
+	ci := h.counter.counts.Values[i].(int)
+	cj := h.counter.counts.Values[j].(int)
+	if ci != cj {
+		return ci > cj
+	}
+	return i < j
+}
+
+func (h *countHeap) Len() int           { return len(h.order) }
+func (h *countHeap) Less(i, j int) bool { return h.before(h.order[j], h.order[i]) }
+func (h *countHeap) Swap(i, j int)      { h.order[i], h.order[j] = h.order[j], h.order[i] }
+func (h *countHeap) Push(x interface{}) { h.order = append(h.order, x.(int)) }
+
+func (h *countHeap) Pop() interface{} {
+	last := h.order[len(h.order)-1]
+	h.order = h.order[:len(h.order)-1]
+	return last
+}
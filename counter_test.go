@@ -0,0 +1,136 @@
+package ezarr
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func letters(s string) *List {
+	list := New()
+	for _, r := range strings.Split(s, "") {
+		list.Append(r)
+	}
+	return list
+}
+
+// Test | Counter verifies counting, Get on missing elements and Total
+func TestCounter(t *testing.T) {
+	c := CounterFromList(letters("abracadabra"))
+
+	if c.Get("a") != 5 || c.Get("r") != 2 || c.Get("z") != 0 {
+		t.Errorf("Unexpected counts in %v", c)
+	}
+	if c.Contains("z") || c.Len() != 5 || c.Total() != 11 {
+		t.Errorf("Expected Get not to insert missing elements, got %v", c)
+	}
+
+	c.Increment("z", 2).Set("a", 1)
+	if c.Get("z") != 2 || c.Get("a") != 1 {
+		t.Errorf("Unexpected counts after Increment and Set: %v", c)
+	}
+	if err := c.Delete("q"); !errors.Is(err, ErrKey) {
+		t.Errorf("Expected KeyError deleting a missing element, got %v", err)
+	}
+
+	keyed := NewCounter(New(1), New(1), NewTuple(1))
+	if keyed.Get(New(1)) != 2 || keyed.Get(NewTuple(1)) != 1 {
+		t.Errorf("Expected elements to be matched with Equal, got %v", keyed)
+	}
+}
+
+// Test | Counter verifies MostCommon ordering against Python results
+func TestCounterMostCommon(t *testing.T) {
+	c := CounterFromList(letters("abracadabra"))
+
+	cases := []struct {
+		n        int
+		expected string
+	}{
+		{3, "[('a', 5), ('b', 2), ('r', 2)]"},
+		{1, "[('a', 5)]"},
+		{0, "[]"},
+		{-1, "[('a', 5), ('b', 2), ('r', 2), ('c', 1), ('d', 1)]"},
+		{10, "[('a', 5), ('b', 2), ('r', 2), ('c', 1), ('d', 1)]"},
+	}
+	for _, tc := range cases {
+		if got := Repr(c.MostCommon(tc.n)); got != tc.expected {
+			t.Errorf("MostCommon(%d): expected %s, got %s", tc.n, tc.expected, got)
+		}
+	}
+
+	if Repr(c) != "Counter({'a': 5, 'b': 2, 'r': 2, 'c': 1, 'd': 1})" {
+		t.Errorf("Unexpected repr: %s", Repr(c))
+	}
+	if Repr(&Counter{}) != "Counter()" || (&Counter{}).String() != "Counter()" {
+		t.Errorf("Unexpected repr for an empty Counter: %s", Repr(&Counter{}))
+	}
+}
+
+// Test | Counter verifies Elements, Update and Subtract
+func TestCounterUpdate(t *testing.T) {
+	c := NewCounter("a", "b", "a")
+	c.Update(New("b", "c")).UpdateCounts(NewCounter("a"))
+	if c.Get("a") != 3 || c.Get("b") != 2 || c.Get("c") != 1 {
+		t.Errorf("Unexpected counts after Update: %v", c)
+	}
+
+	c.Subtract(New("c", "c")).SubtractCounts(NewCounter("b"))
+	if c.Get("c") != -1 || c.Get("b") != 1 {
+		t.Errorf("Expected Subtract to keep non-positive counts, got %v", c)
+	}
+	if !Equal(c.Elements(), New("a", "a", "a", "b")) {
+		t.Errorf("Expected [a, a, a, b], got %v", c.Elements())
+	}
+
+	if c.SubtractCounts(c); c.Get("a") != 0 || c.Get("c") != 0 {
+		t.Errorf("Expected subtracting a counter from itself to zero it, got %v", c)
+	}
+}
+
+// Test | Counter verifies multiset operators drop non-positive counts like Python
+func TestCounterArithmetic(t *testing.T) {
+	a := NewCounter().Set("x", 3).Set("y", 1).Set("w", -2)
+	b := NewCounter().Set("x", 1).Set("y", 2).Set("z", 4).Set("v", -1)
+
+	cases := []struct {
+		name     string
+		got      *Counter
+		expected string
+	}{
+		{"add", a.Add(b), "Counter({'x': 4, 'z': 4, 'y': 3})"},
+		{"sub", a.Sub(b), "Counter({'x': 2, 'v': 1})"},
+		{"and", a.And(b), "Counter({'x': 1, 'y': 1})"},
+		{"or", a.Or(b), "Counter({'z': 4, 'x': 3, 'y': 2})"},
+	}
+	for _, c := range cases {
+		if got := Repr(c.got); got != c.expected {
+			t.Errorf("%s: expected %s, got %s", c.name, c.expected, got)
+		}
+	}
+
+	if !a.Add(b).Equal(b.Add(a)) || a.Equal(b) {
+		t.Error("Unexpected Equal result")
+	}
+	if !NewCounter("a").Equal(NewCounter("a").Set("b", 0)) {
+		t.Error("Expected missing elements to count as zero in Equal")
+	}
+
+	data, err := json.Marshal(NewCounter("a", "b", "a"))
+	if err != nil || string(data) != `{"a":2,"b":1}` {
+		t.Errorf("Expected {\"a\":2,\"b\":1}, got %s, error: %v", data, err)
+	}
+}
+
+func BenchmarkCounterFromList(b *testing.B) {
+	list := New()
+	for i := 0; i < 10000; i++ {
+		list.Append(i % 1000)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		CounterFromList(list)
+	}
+}
@@ -0,0 +1,71 @@
+package ezarr
+
+// DefaultDict is a Dict that calls Factory to create the value for a
+// missing key, like Python's collections.defaultdict. Only Get and
+// GetOrCreate call Factory; Contains, GetDefault and the other Dict methods
+// behave exactly as they do on a Dict.
+type DefaultDict struct {
+	Dict
+	Factory func() interface{}
+}
+
+func NewDefaultDict(factory func() interface{}) *DefaultDict {
+	return &DefaultDict{Factory: factory}
+}
+
+// Get returns the value for key. A missing key is set to a value from
+// Factory, or reported as a KeyError when Factory is nil.
+func (d *DefaultDict) Get(key interface{}) (interface{}, error) {
+	index := d.findIndex(key)
+	if index != -1 {
+		return d.Values[index], nil
+	}
+	if d.Factory == nil {
+		return nil, keyNotFound(key)
+	}
+	return d.insert(key), nil
+}
+
+// GetOrCreate is Get for callers that know Factory is set. Without a
+// Factory it stores nil for a missing key, like Python's setdefault.
+func (d *DefaultDict) GetOrCreate(key interface{}) interface{} {
+	index := d.findIndex(key)
+	if index != -1 {
+		return d.Values[index]
+	}
+	return d.insert(key)
+}
+
+func (d *DefaultDict) Copy() *DefaultDict {
+	result := &DefaultDict{
+		Dict: Dict{
+			Keys:   append([]interface{}{}, d.Keys...),
+			Values: append([]interface{}{}, d.Values...),
+		},
+		Factory: d.Factory,
+	}
+	result.index.rebuild(result.Keys)
+	return result
+}
+
+func (d *DefaultDict) String() string {
+	if reprStrings.Load() {
+		return Repr(d)
+	}
+	return "defaultdict(" + d.Dict.String() + ")"
+}
+
+func (d *DefaultDict) Repr() string {
+	return Repr(d)
+}
+
+// insert sets key to a new value from Factory. The key is looked up again
+// by Set because Factory may have changed the dictionary.
+func (d *DefaultDict) insert(key interface{}) interface{} {
+	var value interface{}
+	if d.Factory != nil {
+		value = d.Factory()
+	}
+	d.Set(key, value)
+	return value
+}
@@ -0,0 +1,92 @@
+package ezarr
+
+import (
+	"errors"
+	"testing"
+)
+
+// Test | DefaultDict verifies that Get inserts a value from the factory
+func TestDefaultDict(t *testing.T) {
+	groups := NewDefaultDict(func() interface{} { return New() })
+
+	for _, word := range []string{"apple", "avocado", "banana"} {
+		group, err := groups.Get(word[:1])
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		group.(*List).Append(word)
+	}
+
+	if Repr(&groups.Dict) != "{'a': ['apple', 'avocado'], 'b': ['banana']}" {
+		t.Errorf("Unexpected groups: %s", Repr(&groups.Dict))
+	}
+
+	counts := NewDefaultDict(func() interface{} { return 0 })
+	counts.Set("x", counts.GetOrCreate("x").(int)+1)
+	counts.Set("x", counts.GetOrCreate("x").(int)+1)
+	if val, _ := counts.Dict.Get("x"); val != 2 {
+		t.Errorf("Expected count 2, got %v", val)
+	}
+}
+
+// Test | DefaultDict verifies that lookups other than Get leave missing keys alone
+func TestDefaultDictMissing(t *testing.T) {
+	calls := 0
+	d := NewDefaultDict(func() interface{} {
+		calls++
+		return calls
+	})
+
+	if d.Contains("k") || d.GetDefault("k", "fallback") != "fallback" || d.Len() != 0 {
+		t.Error("Expected Contains and GetDefault not to insert")
+	}
+	if _, err := d.Pop("k"); !errors.Is(err, ErrKey) {
+		t.Errorf("Expected KeyError from Pop, got %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("Expected the factory not to be called, got %d calls", calls)
+	}
+
+	if val, _ := d.Get("k"); val != 1 {
+		t.Errorf("Expected 1 from the factory, got %v", val)
+	}
+	if val, _ := d.Get("k"); val != 1 || calls != 1 {
+		t.Errorf("Expected the stored value on the second Get, got %v after %d calls", val, calls)
+	}
+
+	var plain DefaultDict
+	if _, err := plain.Get("k"); !errors.Is(err, ErrKey) {
+		t.Errorf("Expected KeyError without a factory, got %v", err)
+	}
+	if plain.GetOrCreate("k") != nil || !plain.Contains("k") {
+		t.Error("Expected GetOrCreate without a factory to store nil")
+	}
+}
+
+// Test | DefaultDict verifies Copy, equality and repr
+func TestDefaultDictCopy(t *testing.T) {
+	d := NewDefaultDict(func() interface{} { return New() })
+	d.Set("a", New(1))
+
+	copied := d.Copy()
+	copied.GetOrCreate("b")
+	if d.Contains("b") || !copied.Contains("b") {
+		t.Error("Expected Copy to return an independent dictionary")
+	}
+	if copied.Factory == nil {
+		t.Error("Expected Copy to keep the factory")
+	}
+
+	other := NewDefaultDict(func() interface{} { return 0 })
+	other.Set("a", New(1))
+	if !Equal(d, other) || Hash(d) != Hash(other) {
+		t.Error("Expected equality and hashing to ignore the factory")
+	}
+
+	if Repr(d) != "defaultdict(<function>, {'a': [1]})" {
+		t.Errorf("Unexpected repr: %s", Repr(d))
+	}
+	if Repr(&DefaultDict{}) != "defaultdict(None, {})" {
+		t.Errorf("Unexpected repr without a factory: %s", Repr(&DefaultDict{}))
+	}
+}
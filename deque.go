@@ -0,0 +1,266 @@
+package ezarr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Deque is a double-ended queue backed by a ring buffer, modeled on
+// Python's collections.deque. Appends and pops at either end are O(1).
+// A bounded Deque discards elements from the opposite end once it is full.
+// The zero value is an empty, unbounded Deque.
+type Deque struct {
+	buf     []interface{}
+	head    int
+	length  int
+	maxlen  int
+	bounded bool
+}
+
+func NewDeque(elements ...interface{}) *Deque {
+	d := &Deque{}
+	for _, e := range elements {
+		d.Append(e)
+	}
+	return d
+}
+
+func NewBoundedDeque(maxlen int, elements ...interface{}) (*Deque, error) {
+	if maxlen < 0 {
+		return nil, &ValueError{Value: maxlen, Msg: "maxlen must be non-negative"}
+	}
+	d := &Deque{maxlen: maxlen, bounded: true}
+	for _, e := range elements {
+		d.Append(e)
+	}
+	return d, nil
+}
+
+func DequeFromList(l *List) *Deque {
+	return NewDeque(l.Elements...)
+}
+
+// MaxLen returns the bound of the Deque and whether it has one.
+func (d *Deque) MaxLen() (int, bool) {
+	return d.maxlen, d.bounded
+}
+
+func (d *Deque) Append(element interface{}) *Deque {
+	if d.bounded && d.length == d.maxlen {
+		if d.maxlen == 0 {
+			return d
+		}
+		d.PopLeft()
+	}
+	d.grow()
+	d.buf[d.position(d.length)] = element
+	d.length++
+	return d
+}
+
+func (d *Deque) AppendLeft(element interface{}) *Deque {
+	if d.bounded && d.length == d.maxlen {
+		if d.maxlen == 0 {
+			return d
+		}
+		d.Pop()
+	}
+	d.grow()
+	d.head = d.position(len(d.buf) - 1)
+	d.buf[d.head] = element
+	d.length++
+	return d
+}
+
+func (d *Deque) Pop() (interface{}, error) {
+	if d.length == 0 {
+		return nil, popFromEmptyDeque()
+	}
+	i := d.position(d.length - 1)
+	element := d.buf[i]
+	d.buf[i] = nil
+	d.length--
+	return element, nil
+}
+
+func (d *Deque) PopLeft() (interface{}, error) {
+	if d.length == 0 {
+		return nil, popFromEmptyDeque()
+	}
+	element := d.buf[d.head]
+	d.buf[d.head] = nil
+	d.head = d.position(1)
+	d.length--
+	return element, nil
+}
+
+func (d *Deque) Extend(other *List) *Deque {
+	for _, e := range append([]interface{}{}, other.Elements...) {
+		d.Append(e)
+	}
+	return d
+}
+
+// ExtendLeft appends the elements of other to the left one at a time, so
+// they end up in reverse order.
+func (d *Deque) ExtendLeft(other *List) *Deque {
+	for _, e := range append([]interface{}{}, other.Elements...) {
+		d.AppendLeft(e)
+	}
+	return d
+}
+
+// Rotate moves the last n elements to the front. A negative n rotates to
+// the left.
+func (d *Deque) Rotate(n int) *Deque {
+	if d.length <= 1 {
+		return d
+	}
+	n %= d.length
+	if n < 0 {
+		n += d.length
+	}
+	if n == 0 {
+		return d
+	}
+
+	if d.length == len(d.buf) {
+		d.head = d.position(d.length - n)
+		return d
+	}
+	if n <= d.length/2 {
+		for i := 0; i < n; i++ {
+			e, _ := d.Pop()
+			d.AppendLeft(e)
+		}
+	} else {
+		for i := 0; i < d.length-n; i++ {
+			e, _ := d.PopLeft()
+			d.Append(e)
+		}
+	}
+	return d
+}
+
+func (d *Deque) Get(index int) (interface{}, error) {
+	position := index
+	if position < 0 {
+		position = d.length + position
+	}
+	if position < 0 || position >= d.length {
+		return nil, indexOutOfRange(index)
+	}
+	return d.buf[d.position(position)], nil
+}
+
+func (d *Deque) Remove(element interface{}) error {
+	index := d.Index(element)
+	if index == -1 {
+		return notInList(element)
+	}
+	for i := index; i < d.length-1; i++ {
+		d.buf[d.position(i)] = d.buf[d.position(i+1)]
+	}
+	d.buf[d.position(d.length-1)] = nil
+	d.length--
+	return nil
+}
+
+func (d *Deque) Index(element interface{}) int {
+	for i := 0; i < d.length; i++ {
+		if Equal(d.buf[d.position(i)], element) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (d *Deque) Count(element interface{}) int {
+	count := 0
+	for i := 0; i < d.length; i++ {
+		if Equal(d.buf[d.position(i)], element) {
+			count++
+		}
+	}
+	return count
+}
+
+func (d *Deque) Reverse() *Deque {
+	for i, j := 0, d.length-1; i < j; i, j = i+1, j-1 {
+		pi, pj := d.position(i), d.position(j)
+		d.buf[pi], d.buf[pj] = d.buf[pj], d.buf[pi]
+	}
+	return d
+}
+
+func (d *Deque) Len() int {
+	return d.length
+}
+
+func (d *Deque) Clear() *Deque {
+	d.buf = nil
+	d.head = 0
+	d.length = 0
+	return d
+}
+
+func (d *Deque) Copy() *Deque {
+	return &Deque{buf: d.elements(), length: d.length, maxlen: d.maxlen, bounded: d.bounded}
+}
+
+func (d *Deque) ToList() *List {
+	return &List{Elements: d.elements()}
+}
+
+func (d *Deque) String() string {
+	if reprStrings.Load() {
+		return Repr(d)
+	}
+	strElems := make([]string, d.length)
+	for i := range strElems {
+		strElems[i] = fmt.Sprintf("%v", d.buf[d.position(i)])
+	}
+	return "deque([" + strings.Join(strElems, ", ") + "])"
+}
+
+func (d *Deque) Repr() string {
+	return Repr(d)
+}
+
+func (d *Deque) elements() []interface{} {
+	elements := make([]interface{}, d.length)
+	for i := range elements {
+		elements[i] = d.buf[d.position(i)]
+	}
+	return elements
+}
+
+func (d Deque) hashElements() ([]interface{}, bool) {
+	return d.elements(), true
+}
+
+// position maps a logical index, counted from the head, to a slot in buf.
+func (d *Deque) position(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+// grow makes room for one more element, unrolling the ring so that the
+// head sits at slot 0 of the new buffer.
+func (d *Deque) grow() {
+	if d.length < len(d.buf) {
+		return
+	}
+	size := 2 * len(d.buf)
+	if size < 8 {
+		size = 8
+	}
+	if d.bounded && size > d.maxlen {
+		size = d.maxlen
+	}
+	buf := make([]interface{}, size)
+	if len(d.buf) > 0 {
+		copy(buf, d.elements())
+	}
+	d.buf = buf
+	d.head = 0
+}
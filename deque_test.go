@@ -0,0 +1,162 @@
+package ezarr
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// Test | Deque verifies appends and pops at both ends
+func TestDeque(t *testing.T) {
+	var d Deque
+	d.Append(2).Append(3).AppendLeft(1).AppendLeft(0)
+	if Repr(&d) != "deque([0, 1, 2, 3])" || d.Len() != 4 {
+		t.Errorf("Expected deque([0, 1, 2, 3]), got %s", Repr(&d))
+	}
+
+	if val, err := d.Pop(); err != nil || val != 3 {
+		t.Errorf("Expected to pop 3, got %v, error: %v", val, err)
+	}
+	if val, err := d.PopLeft(); err != nil || val != 0 {
+		t.Errorf("Expected to pop 0 from the left, got %v, error: %v", val, err)
+	}
+	if val, err := d.Get(-1); err != nil || val != 2 {
+		t.Errorf("Expected 2 at index -1, got %v, error: %v", val, err)
+	}
+	if _, err := d.Get(2); !errors.Is(err, ErrIndex) {
+		t.Errorf("Expected IndexError for index 2, got %v", err)
+	}
+
+	d.Clear()
+	if _, err := d.Pop(); !errors.Is(err, ErrIndex) || !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected empty IndexError, got %v", err)
+	}
+	if _, err := d.PopLeft(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected empty IndexError popping left, got %v", err)
+	}
+
+	// Alternate ends long enough to wrap around and grow the ring buffer.
+	q := NewDeque()
+	for i := 0; i < 100; i++ {
+		q.Append(i)
+		if i%3 == 0 {
+			q.PopLeft()
+		}
+	}
+	first, _ := q.Get(0)
+	if q.Len() != 66 || first != 34 {
+		t.Errorf("Expected 66 elements starting at 34, got %d starting at %v", q.Len(), first)
+	}
+}
+
+// Test | Deque verifies Extend, ExtendLeft and conversions to and from List
+func TestDequeExtend(t *testing.T) {
+	d := DequeFromList(New(3, 4))
+	d.Extend(New(5, 6)).ExtendLeft(New(2, 1))
+	if !Equal(d.ToList(), New(1, 2, 3, 4, 5, 6)) {
+		t.Errorf("Expected [1, 2, 3, 4, 5, 6], got %v", d.ToList())
+	}
+
+	d.Extend(d.ToList())
+	if d.Len() != 12 {
+		t.Errorf("Expected extending with its own elements to double the deque, got %v", d)
+	}
+
+	copied := d.Copy()
+	copied.Append(7)
+	if d.Len() != 12 || copied.Len() != 13 {
+		t.Error("Expected Copy to return an independent deque")
+	}
+}
+
+// Test | Deque verifies Rotate in both directions against Python results
+func TestDequeRotate(t *testing.T) {
+	cases := []struct {
+		n        int
+		expected *List
+	}{
+		{1, New(5, 1, 2, 3, 4)},
+		{-1, New(2, 3, 4, 5, 1)},
+		{3, New(3, 4, 5, 1, 2)},
+		{7, New(4, 5, 1, 2, 3)},
+		{-12, New(3, 4, 5, 1, 2)},
+		{0, New(1, 2, 3, 4, 5)},
+	}
+	for _, c := range cases {
+		d := NewDeque(1, 2, 3, 4, 5)
+		if got := d.Rotate(c.n).ToList(); !Equal(got, c.expected) {
+			t.Errorf("Rotate(%d): expected %v, got %v", c.n, c.expected, got)
+		}
+
+		full, _ := NewBoundedDeque(5, 1, 2, 3, 4, 5)
+		if got := full.Rotate(c.n).ToList(); !Equal(got, c.expected) {
+			t.Errorf("Rotate(%d) on a full buffer: expected %v, got %v", c.n, c.expected, got)
+		}
+	}
+
+	empty := NewDeque()
+	if empty.Rotate(3).Len() != 0 {
+		t.Error("Expected rotating an empty deque to be a no-op")
+	}
+}
+
+// Test | Deque verifies that a maxlen evicts from the opposite end
+func TestBoundedDeque(t *testing.T) {
+	d, err := NewBoundedDeque(3, 1, 2, 3, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if Repr(d) != "deque([2, 3, 4], maxlen=3)" {
+		t.Errorf("Expected deque([2, 3, 4], maxlen=3), got %s", Repr(d))
+	}
+
+	d.AppendLeft(1)
+	if !Equal(d.ToList(), New(1, 2, 3)) {
+		t.Errorf("Expected AppendLeft to evict from the right, got %v", d)
+	}
+	d.Extend(New(9, 8))
+	if !Equal(d.ToList(), New(3, 9, 8)) {
+		t.Errorf("Expected [3, 9, 8], got %v", d)
+	}
+	if maxlen, ok := d.MaxLen(); !ok || maxlen != 3 {
+		t.Errorf("Expected maxlen 3, got %d, %v", maxlen, ok)
+	}
+
+	zero, _ := NewBoundedDeque(0)
+	if zero.Append(1).AppendLeft(2).Len() != 0 {
+		t.Errorf("Expected a zero-length deque to stay empty, got %v", zero)
+	}
+	if _, err := NewBoundedDeque(-1); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError for a negative maxlen, got %v", err)
+	}
+}
+
+// Test | Deque verifies Remove, Count, Reverse, equality and JSON encoding
+func TestDequeHelpers(t *testing.T) {
+	d := NewDeque("a", "b", "a", "c")
+	if err := d.Remove("a"); err != nil || !Equal(d.ToList(), New("b", "a", "c")) {
+		t.Errorf("Expected the first 'a' to be removed, got %v, error: %v", d, err)
+	}
+	if err := d.Remove("z"); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError removing a missing element, got %v", err)
+	}
+	if d.Count("a") != 1 || d.Index("c") != 2 {
+		t.Errorf("Unexpected Count or Index result for %v", d)
+	}
+	if !Equal(d.Reverse().ToList(), New("c", "a", "b")) {
+		t.Errorf("Expected [c, a, b], got %v", d)
+	}
+
+	// Same elements at different ring positions.
+	rotated := NewDeque(0, "c", "a")
+	rotated.PopLeft()
+	rotated.Append("b")
+	if !Equal(d, rotated) || Hash(d) != Hash(rotated) {
+		t.Errorf("Expected %v and %v to be equal and hash alike", d, rotated)
+	}
+
+	data, err := json.Marshal(d)
+	if err != nil || string(data) != `["c","a","b"]` {
+		t.Errorf("Expected [\"c\",\"a\",\"b\"], got %s, error: %v", data, err)
+	}
+}
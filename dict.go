@@ -2,18 +2,29 @@ package ezarr
 
 import (
 	"fmt"
-	"reflect"
 	"strings"
 )
 
+// Dict is an insertion-ordered mapping. Keys and Values may be read and
+// written directly. Lookups go through a hash index that Dict's methods keep
+// up to date; after Keys has been changed directly, a key missing from the
+// index is looked for by a linear scan until the next Set, Delete or Clear
+// rebuilds the index.
 type Dict struct {
 	Keys   []interface{}
 	Values []interface{}
+	index  dictIndex
+}
+
+func (d Dict) hashElements() ([]interface{}, bool) {
+	elements := make([]interface{}, 0, len(d.Keys)+len(d.Values))
+	elements = append(elements, d.Keys...)
+	return append(elements, d.Values...), true
 }
 
 func NewDict(pairs ...interface{}) (*Dict, error) {
 	if len(pairs)%2 != 0 {
-		return nil, fmt.Errorf("number of arguments must be even")
+		return nil, &ValueError{Value: len(pairs), Msg: "number of arguments must be even"}
 	}
 
 	d := &Dict{
@@ -27,6 +38,7 @@ func NewDict(pairs ...interface{}) (*Dict, error) {
 		d.Values[index] = pairs[i+1]
 	}
 
+	d.index.rebuild(d.Keys)
 	return d, nil
 }
 
@@ -41,13 +53,14 @@ func FromKeys(keys []interface{}, value interface{}) *Dict {
 		d.Values[i] = value
 	}
 
+	d.index.rebuild(d.Keys)
 	return d
 }
 
 func (d *Dict) Get(key interface{}) (interface{}, error) {
 	index := d.findIndex(key)
 	if index == -1 {
-		return nil, fmt.Errorf("key %v not found", key)
+		return nil, keyNotFound(key)
 	}
 	return d.Values[index], nil
 }
@@ -61,12 +74,15 @@ func (d *Dict) GetDefault(key, defaultValue interface{}) interface{} {
 }
 
 func (d *Dict) Set(key, value interface{}) *Dict {
-	index := d.findIndex(key)
+	index, h := d.lookup(key)
+	if index == -1 && !d.index.current(d.Keys) {
+		d.syncIndex()
+		index = d.index.find(h, key, d.Keys)
+	}
 	if index != -1 {
 		d.Values[index] = value
 	} else {
-		d.Keys = append(d.Keys, key)
-		d.Values = append(d.Values, value)
+		d.insert(h, key, value)
 	}
 	return d
 }
@@ -74,11 +90,11 @@ func (d *Dict) Set(key, value interface{}) *Dict {
 func (d *Dict) Delete(key interface{}) error {
 	index := d.findIndex(key)
 	if index == -1 {
-		return fmt.Errorf("key %v not found", key)
+		return keyNotFound(key)
 	}
 
-	d.Keys = append(d.Keys[:index], d.Keys[index+1:]...)
-	d.Values = append(d.Values[:index], d.Values[index+1:]...)
+	d.syncIndex()
+	d.removeAt(index)
 	return nil
 }
 
@@ -103,6 +119,14 @@ func (d *Dict) GetItems() *List {
 	return &List{Elements: items}
 }
 
+func (d *Dict) GetItemTuples() *List {
+	items := make([]interface{}, len(d.Keys))
+	for i := range d.Keys {
+		items[i] = NewTuple(d.Keys[i], d.Values[i])
+	}
+	return &List{Elements: items}
+}
+
 func (d *Dict) Len() int {
 	return len(d.Keys)
 }
@@ -110,10 +134,14 @@ func (d *Dict) Len() int {
 func (d *Dict) Clear() *Dict {
 	d.Keys = []interface{}{}
 	d.Values = []interface{}{}
+	d.index = dictIndex{}
 	return d
 }
 
 func (d *Dict) String() string {
+	if reprStrings.Load() {
+		return Repr(d)
+	}
 	pairs := make([]string, len(d.Keys))
 	for i := range d.Keys {
 		pairs[i] = fmt.Sprintf("%v: %v", d.Keys[i], d.Values[i])
@@ -141,6 +169,7 @@ func (d *Dict) Merge(other *Dict) *Dict {
 	copy(result.Keys, d.Keys)
 	copy(result.Values, d.Values)
 
+	result.index.rebuild(result.Keys)
 	for i, key := range other.Keys {
 		result.Set(key, other.Values[i])
 	}
@@ -151,39 +180,148 @@ func (d *Dict) Merge(other *Dict) *Dict {
 func (d *Dict) Pop(key interface{}) (interface{}, error) {
 	index := d.findIndex(key)
 	if index == -1 {
-		return nil, fmt.Errorf("key %v not found", key)
+		return nil, keyNotFound(key)
 	}
 
 	value := d.Values[index]
-
-	d.Keys = append(d.Keys[:index], d.Keys[index+1:]...)
-	d.Values = append(d.Values[:index], d.Values[index+1:]...)
-
+	d.syncIndex()
+	d.removeAt(index)
 	return value, nil
 }
 
 func (d *Dict) PopItem() (interface{}, interface{}, error) {
 	if len(d.Keys) == 0 {
-		return nil, nil, fmt.Errorf("dictionary is empty")
+		return nil, nil, dictIsEmpty()
 	}
 
 	lastIndex := len(d.Keys) - 1
 	key := d.Keys[lastIndex]
 	value := d.Values[lastIndex]
+	d.syncIndex()
+	d.removeAt(lastIndex)
+	return key, value, nil
+}
 
-	d.Keys = d.Keys[:lastIndex]
-	d.Values = d.Values[:lastIndex]
+// PopItemAt removes and returns the last item when last is true and the
+// first item otherwise. Removing the first item shifts every other item, so
+// it takes O(n) time, unlike popitem(last=False) on a Python OrderedDict.
+func (d *Dict) PopItemAt(last bool) (interface{}, interface{}, error) {
+	if last {
+		return d.PopItem()
+	}
+	if len(d.Keys) == 0 {
+		return nil, nil, dictIsEmpty()
+	}
 
+	key := d.Keys[0]
+	value := d.Values[0]
+	d.syncIndex()
+	d.removeAt(0)
 	return key, value, nil
 }
 
+// MoveToEnd moves an existing key to the end of the order when last is true
+// and to the front otherwise. It shifts the items it moves past, so it
+// takes O(n) time, unlike the O(1) move_to_end of a Python OrderedDict; an
+// LRU cache built on it pays that on every access.
+func (d *Dict) MoveToEnd(key interface{}, last bool) error {
+	index := d.findIndex(key)
+	if index == -1 {
+		return keyNotFound(key)
+	}
+
+	k, v := d.Keys[index], d.Values[index]
+	if last {
+		if index == len(d.Keys)-1 {
+			return nil
+		}
+		d.syncIndex()
+		d.removeAt(index)
+		d.insert(Hash(k), k, v)
+		return nil
+	}
+
+	d.syncIndex()
+	copy(d.Keys[1:index+1], d.Keys[:index])
+	copy(d.Values[1:index+1], d.Values[:index])
+	d.Keys[0], d.Values[0] = k, v
+	d.index.moveToFront(Hash(k), index)
+	return nil
+}
+
+// Equal compares d and other by their items. When ordered is true the items
+// must also be in the same order, like comparing two OrderedDicts in Python.
+func (d *Dict) Equal(other *Dict, ordered bool) bool {
+	if len(d.Keys) != len(other.Keys) {
+		return false
+	}
+	if ordered {
+		return Equal(d, other)
+	}
+	for i, key := range d.Keys {
+		index := other.findIndex(key)
+		if index == -1 || !Equal(d.Values[i], other.Values[index]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reversed returns the keys from the last inserted to the first.
+func (d *Dict) Reversed() *List {
+	keys := make([]interface{}, len(d.Keys))
+	for i, key := range d.Keys {
+		keys[len(keys)-1-i] = key
+	}
+	return &List{Elements: keys}
+}
+
+// findIndex returns the position of key in d.Keys, or -1. It trusts a miss
+// in the index only while Keys is unchanged since the index was last
+// updated, and scans Keys otherwise.
 func (d *Dict) findIndex(key interface{}) int {
-	for i, k := range d.Keys {
-		if reflect.DeepEqual(k, key) {
-			return i
+	index, _ := d.lookup(key)
+	if index == -1 && !d.index.current(d.Keys) {
+		for i, k := range d.Keys {
+			if Equal(k, key) {
+				return i
+			}
 		}
 	}
-	return -1
+	return index
+}
+
+// lookup returns the position of key in d.Keys according to the index, or
+// -1, along with the hash of key. A miss is only reliable for the Dicts that
+// Set, Counter and SortedDict keep internally, whose Keys are never written
+// outside of Dict's methods.
+func (d *Dict) lookup(key interface{}) (int, uint64) {
+	h := Hash(key)
+	return d.index.find(h, key, d.Keys), h
+}
+
+func (d *Dict) insert(h uint64, key, value interface{}) {
+	d.Keys = append(d.Keys, key)
+	d.Values = append(d.Values, value)
+	d.index.add(h, key)
+}
+
+// syncIndex rebuilds the index when Keys has changed since it was last
+// updated.
+func (d *Dict) syncIndex() {
+	if !d.index.current(d.Keys) {
+		d.index.rebuild(d.Keys)
+	}
+}
+
+// removeAt removes the item at position index, which shifts the items after
+// it. The index must be in sync with Keys, as it always is for the Dicts
+// kept internally; the exported methods call syncIndex first.
+func (d *Dict) removeAt(index int) {
+	key := d.Keys[index]
+	d.Keys = append(d.Keys[:index], d.Keys[index+1:]...)
+	d.Values = append(d.Values[:index], d.Values[index+1:]...)
+	d.index.remove(Hash(key), index)
 }
 
 func (d *Dict) Filter(filterFunc func(key, value interface{}) bool) *Dict {
@@ -199,5 +337,6 @@ func (d *Dict) Filter(filterFunc func(key, value interface{}) bool) *Dict {
 		}
 	}
 
+	result.index.rebuild(result.Keys)
 	return result
 }
@@ -1,6 +1,7 @@
 package ezarr
 
 import (
+	"errors"
 	"strings"
 	"testing"
 )
@@ -378,3 +379,104 @@ func TestFilter(t *testing.T) {
 		t.Errorf("Original dict should be unchanged, length should be 4, got %d", dict.Len())
 	}
 }
+
+func TestPopItemAt(t *testing.T) {
+	dict, _ := NewDict("a", 1, "b", 2, "c", 3)
+
+	key, value, err := dict.PopItemAt(false)
+	if err != nil || key != "a" || value != 1 {
+		t.Errorf("Expected a: 1 from the front, got %v: %v, error: %v", key, value, err)
+	}
+
+	key, value, err = dict.PopItemAt(true)
+	if err != nil || key != "c" || value != 3 {
+		t.Errorf("Expected c: 3 from the back, got %v: %v, error: %v", key, value, err)
+	}
+
+	if val, err := dict.Get("b"); err != nil || val != 2 || dict.Len() != 1 {
+		t.Errorf("Expected only b: 2 to remain, got %v", dict)
+	}
+
+	dict.PopItemAt(false)
+	if _, _, err := dict.PopItemAt(false); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected empty KeyError, got %v", err)
+	}
+}
+
+func TestMoveToEnd(t *testing.T) {
+	dict, _ := NewDict("a", 1, "b", 2, "c", 3, "d", 4)
+
+	if err := dict.MoveToEnd("b", true); err != nil {
+		t.Errorf("MoveToEnd returned error: %v", err)
+	}
+	if Repr(dict) != "{'a': 1, 'c': 3, 'd': 4, 'b': 2}" {
+		t.Errorf("Expected b to move to the end, got %s", Repr(dict))
+	}
+
+	if err := dict.MoveToEnd("d", false); err != nil {
+		t.Errorf("MoveToEnd returned error: %v", err)
+	}
+	if Repr(dict) != "{'d': 4, 'a': 1, 'c': 3, 'b': 2}" {
+		t.Errorf("Expected d to move to the front, got %s", Repr(dict))
+	}
+
+	dict.MoveToEnd("b", true)
+	dict.MoveToEnd("d", false)
+	for i, key := range []string{"d", "a", "c", "b"} {
+		if val, _ := dict.Get(key); val != dict.Values[i] {
+			t.Errorf("Expected lookups to follow the new order, got %v for %s", val, key)
+		}
+	}
+
+	if err := dict.MoveToEnd("missing", true); !errors.Is(err, ErrKey) {
+		t.Errorf("Expected KeyError for a missing key, got %v", err)
+	}
+}
+
+func TestDictEqual(t *testing.T) {
+	d1, _ := NewDict("a", 1, "b", New(2))
+	d2, _ := NewDict("b", New(2), "a", 1)
+	d3, _ := NewDict("a", 1, "b", New(3))
+
+	if !d1.Equal(d2, false) || d1.Equal(d2, true) {
+		t.Error("Expected dicts in a different order to be equal only when order is ignored")
+	}
+	if !d1.Equal(d1.Merge(&Dict{}), true) {
+		t.Error("Expected a copy to be equal when order matters")
+	}
+	if d1.Equal(d3, false) || d1.Equal(&Dict{}, false) {
+		t.Error("Expected dicts with different items to be unequal")
+	}
+}
+
+func TestReversed(t *testing.T) {
+	dict, _ := NewDict("a", 1, "b", 2, "c", 3)
+
+	if !Equal(dict.Reversed(), New("c", "b", "a")) {
+		t.Errorf("Expected [c, b, a], got %v", dict.Reversed())
+	}
+	if (&Dict{}).Reversed().Len() != 0 {
+		t.Error("Expected an empty list for an empty dict")
+	}
+}
+
+func TestDictLRU(t *testing.T) {
+	cache := &Dict{}
+	get := func(key string) {
+		if cache.Contains(key) {
+			cache.MoveToEnd(key, true)
+			return
+		}
+		cache.Set(key, strings.ToUpper(key))
+		if cache.Len() > 2 {
+			cache.PopItemAt(false)
+		}
+	}
+
+	for _, key := range []string{"a", "b", "a", "c", "d"} {
+		get(key)
+	}
+	if Repr(cache) != "{'c': 'C', 'd': 'D'}" {
+		t.Errorf("Expected the least recently used keys to be evicted, got %s", Repr(cache))
+	}
+}
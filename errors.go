@@ -0,0 +1,142 @@
+package ezarr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinels for use with errors.Is. They mirror Python's IndexError,
+// KeyError, ValueError, TypeError and RuntimeError; ErrEmpty additionally
+// matches errors caused by popping from an empty container.
+var (
+	ErrIndex   = errors.New("index error")
+	ErrKey     = errors.New("key error")
+	ErrValue   = errors.New("value error")
+	ErrType    = errors.New("type error")
+	ErrEmpty   = errors.New("container is empty")
+	ErrSyntax  = errors.New("syntax error")
+	ErrRuntime = errors.New("runtime error")
+)
+
+type IndexError struct {
+	Index int
+	Empty bool
+	Msg   string
+}
+
+func (e *IndexError) Error() string {
+	return e.Msg
+}
+
+func (e *IndexError) Is(target error) bool {
+	return target == ErrIndex || e.Empty && target == ErrEmpty
+}
+
+type KeyError struct {
+	Key   interface{}
+	Empty bool
+	Msg   string
+}
+
+func (e *KeyError) Error() string {
+	return e.Msg
+}
+
+func (e *KeyError) Is(target error) bool {
+	return target == ErrKey || e.Empty && target == ErrEmpty
+}
+
+type ValueError struct {
+	Value interface{}
+	Msg   string
+}
+
+func (e *ValueError) Error() string {
+	return e.Msg
+}
+
+func (e *ValueError) Is(target error) bool {
+	return target == ErrValue
+}
+
+type TypeError struct {
+	Value interface{}
+	Msg   string
+}
+
+func (e *TypeError) Error() string {
+	return e.Msg
+}
+
+func (e *TypeError) Is(target error) bool {
+	return target == ErrType
+}
+
+type SyntaxError struct {
+	Offset int
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return e.Msg
+}
+
+func (e *SyntaxError) Is(target error) bool {
+	return target == ErrSyntax
+}
+
+// RuntimeError is the value iterators panic with when their container
+// changes size during iteration.
+type RuntimeError struct {
+	Msg string
+}
+
+func (e *RuntimeError) Error() string {
+	return e.Msg
+}
+
+func (e *RuntimeError) Is(target error) bool {
+	return target == ErrRuntime
+}
+
+func indexOutOfRange(index int) error {
+	return &IndexError{Index: index, Msg: fmt.Sprintf("index %d out of range", index)}
+}
+
+func popFromEmpty(index int) error {
+	return &IndexError{Index: index, Empty: true, Msg: "cannot pop from empty list"}
+}
+
+func keyNotFound(key interface{}) error {
+	return &KeyError{Key: key, Msg: fmt.Sprintf("key %v not found", key)}
+}
+
+func firstMappingKeyError(key interface{}) error {
+	return &KeyError{Key: key, Msg: fmt.Sprintf("key %v not found in the first mapping", key)}
+}
+
+func dictIsEmpty() error {
+	return &KeyError{Empty: true, Msg: "dictionary is empty"}
+}
+
+func popFromEmptyHeap() error {
+	return &IndexError{Empty: true, Msg: "pop from an empty heap"}
+}
+
+func popFromEmptyDeque() error {
+	return &IndexError{Empty: true, Msg: "pop from an empty deque"}
+}
+
+func popFromEmptySet() error {
+	return &KeyError{Empty: true, Msg: "pop from an empty set"}
+}
+
+func notInList(element interface{}) error {
+	return &ValueError{Value: element, Msg: fmt.Sprintf("element %v not found in list", element)}
+}
+
+func changedSize(container string) error {
+	return &RuntimeError{Msg: container + " changed size during iteration"}
+}
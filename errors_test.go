@@ -0,0 +1,88 @@
+package ezarr
+
+import (
+	"errors"
+	"testing"
+)
+
+// Test | List errors verify that failures match the Python-style sentinels and carry details
+func TestListErrors(t *testing.T) {
+	list := New(1, 2, 3)
+
+	_, err := list.Pop(-5)
+	var indexErr *IndexError
+	if !errors.Is(err, ErrIndex) || !errors.As(err, &indexErr) || indexErr.Index != -5 {
+		t.Errorf("Expected IndexError for index -5, got %v", err)
+	}
+	if errors.Is(err, ErrEmpty) {
+		t.Error("Expected out-of-range error not to match ErrEmpty")
+	}
+
+	err = list.Remove(4)
+	var valueErr *ValueError
+	if !errors.Is(err, ErrValue) || !errors.As(err, &valueErr) || valueErr.Value != 4 {
+		t.Errorf("Expected ValueError for element 4, got %v", err)
+	}
+
+	_, err = New().Pop(0)
+	if !errors.Is(err, ErrEmpty) || !errors.Is(err, ErrIndex) {
+		t.Errorf("Expected empty IndexError, got %v", err)
+	}
+
+	err = New(1, "a").Sort()
+	if !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError, got %v", err)
+	}
+
+	_, err = list.SliceStep(0, 1, 0)
+	if !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError for zero step, got %v", err)
+	}
+}
+
+// Test | Dict errors verify that failures match the Python-style sentinels and carry details
+func TestDictErrors(t *testing.T) {
+	dict, _ := NewDict("a", 1)
+
+	_, err := dict.Get("b")
+	var keyErr *KeyError
+	if !errors.Is(err, ErrKey) || !errors.As(err, &keyErr) || keyErr.Key != "b" {
+		t.Errorf("Expected KeyError for key 'b', got %v", err)
+	}
+
+	if err := dict.Delete("b"); !errors.Is(err, ErrKey) {
+		t.Errorf("Expected KeyError from Delete, got %v", err)
+	}
+	if _, err := dict.Pop("b"); !errors.Is(err, ErrKey) {
+		t.Errorf("Expected KeyError from Pop, got %v", err)
+	}
+
+	dict.Clear()
+	_, _, err = dict.PopItem()
+	if !errors.Is(err, ErrEmpty) || !errors.Is(err, ErrKey) {
+		t.Errorf("Expected empty KeyError, got %v", err)
+	}
+
+	_, err = NewDict("a")
+	if !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError for odd arguments, got %v", err)
+	}
+}
+
+// Test | Typed container errors verify that generic containers report the same sentinels
+func TestTypedErrors(t *testing.T) {
+	if _, err := NewTypedList[int]().Pop(0); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected empty IndexError, got %v", err)
+	}
+	if _, err := TypedListFrom[int](New("a")); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError, got %v", err)
+	}
+
+	dict := NewTypedDict[string, int]()
+	if _, err := dict.Get("a"); !errors.Is(err, ErrKey) {
+		t.Errorf("Expected KeyError, got %v", err)
+	}
+	if _, _, err := dict.PopItem(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected empty KeyError, got %v", err)
+	}
+}
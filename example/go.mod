@@ -1,6 +1,6 @@
 module github.com/NovaDAndrew/ezarr/example
 
-go 1.20
+go 1.23
 
 require github.com/NovaDAndrew/ezarr v0.0.0
 
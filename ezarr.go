@@ -2,7 +2,6 @@ package ezarr
 
 import (
 	"fmt"
-	"reflect"
 	"sort"
 	"strings"
 )
@@ -11,6 +10,10 @@ type List struct {
 	Elements []interface{}
 }
 
+func (l List) hashElements() ([]interface{}, bool) {
+	return l.Elements, true
+}
+
 func New(elements ...interface{}) *List {
 	return &List{Elements: elements}
 }
@@ -26,16 +29,7 @@ func (l *List) Extend(other *List) *List {
 }
 
 func (l *List) Insert(index int, element interface{}) *List {
-	if index < 0 {
-		index = len(l.Elements) + index
-		if index < 0 {
-			index = 0
-		}
-	}
-	if index > len(l.Elements) {
-		index = len(l.Elements)
-	}
-
+	index = clampIndex(len(l.Elements), index)
 	l.Elements = append(l.Elements[:index], append([]interface{}{element}, l.Elements[index:]...)...)
 	return l
 }
@@ -43,7 +37,7 @@ func (l *List) Insert(index int, element interface{}) *List {
 func (l *List) Remove(element interface{}) error {
 	index := l.Index(element)
 	if index == -1 {
-		return fmt.Errorf("element %v not found in list", element)
+		return notInList(element)
 	}
 	l.Elements = append(l.Elements[:index], l.Elements[index+1:]...)
 	return nil
@@ -51,25 +45,26 @@ func (l *List) Remove(element interface{}) error {
 
 func (l *List) Pop(index int) (interface{}, error) {
 	if len(l.Elements) == 0 {
-		return nil, fmt.Errorf("cannot pop from empty list")
+		return nil, popFromEmpty(index)
 	}
 
-	if index < 0 {
-		index = len(l.Elements) + index
+	position := index
+	if position < 0 {
+		position = len(l.Elements) + position
 	}
 
-	if index < 0 || index >= len(l.Elements) {
-		return nil, fmt.Errorf("index %d out of range", index)
+	if position < 0 || position >= len(l.Elements) {
+		return nil, indexOutOfRange(index)
 	}
 
-	element := l.Elements[index]
-	l.Elements = append(l.Elements[:index], l.Elements[index+1:]...)
+	element := l.Elements[position]
+	l.Elements = append(l.Elements[:position], l.Elements[position+1:]...)
 	return element, nil
 }
 
 func (l *List) Index(element interface{}) int {
 	for i, e := range l.Elements {
-		if reflect.DeepEqual(e, element) {
+		if Equal(e, element) {
 			return i
 		}
 	}
@@ -79,7 +74,7 @@ func (l *List) Index(element interface{}) int {
 func (l *List) Count(element interface{}) int {
 	count := 0
 	for _, e := range l.Elements {
-		if reflect.DeepEqual(e, element) {
+		if Equal(e, element) {
 			count++
 		}
 	}
@@ -87,37 +82,54 @@ func (l *List) Count(element interface{}) int {
 }
 
 func (l *List) Sort() error {
-	if len(l.Elements) == 0 {
-		return nil
-	}
+	return l.SortBy(nil, false)
+}
 
-	firstType := reflect.TypeOf(l.Elements[0])
-	for _, e := range l.Elements {
-		if reflect.TypeOf(e) != firstType {
-			return fmt.Errorf("cannot sort list with mixed types")
+func (l *List) SortBy(key func(interface{}) interface{}, reverse bool) error {
+	keys := l.Elements
+	if key != nil {
+		keys = make([]interface{}, len(l.Elements))
+		for i, e := range l.Elements {
+			keys[i] = key(e)
 		}
 	}
 
-	switch l.Elements[0].(type) {
-	case int:
-		sort.Slice(l.Elements, func(i, j int) bool {
-			return l.Elements[i].(int) < l.Elements[j].(int)
-		})
-	case float64:
-		sort.Slice(l.Elements, func(i, j int) bool {
-			return l.Elements[i].(float64) < l.Elements[j].(float64)
-		})
-	case string:
-		sort.Slice(l.Elements, func(i, j int) bool {
-			return l.Elements[i].(string) < l.Elements[j].(string)
-		})
-	default:
-		return fmt.Errorf("cannot sort elements of type %s", firstType.String())
+	order := make([]int, len(l.Elements))
+	for i := range order {
+		order[i] = i
+	}
+
+	var err error
+	sort.SliceStable(order, func(i, j int) bool {
+		if err != nil {
+			return false
+		}
+		a, b := keys[order[i]], keys[order[j]]
+		if reverse {
+			a, b = b, a
+		}
+		c, cmpErr := Compare(a, b)
+		if cmpErr != nil {
+			err = cmpErr
+			return false
+		}
+		return c < 0
+	})
+	if err != nil {
+		return err
 	}
 
+	copy(l.Elements, permute(l.Elements, order))
 	return nil
 }
 
+func (l *List) SortFunc(less func(a, b interface{}) bool) *List {
+	sort.SliceStable(l.Elements, func(i, j int) bool {
+		return less(l.Elements[i], l.Elements[j])
+	})
+	return l
+}
+
 func (l *List) Reverse() *List {
 	for i, j := 0, len(l.Elements)-1; i < j; i, j = i+1, j-1 {
 		l.Elements[i], l.Elements[j] = l.Elements[j], l.Elements[i]
@@ -126,31 +138,7 @@ func (l *List) Reverse() *List {
 }
 
 func (l *List) Slice(start, end int) *List {
-	len := len(l.Elements)
-
-	if start < 0 {
-		start = len + start
-		if start < 0 {
-			start = 0
-		}
-	}
-	if end < 0 {
-		end = len + end
-		if end < 0 {
-			end = 0
-		}
-	}
-
-	if start > len {
-		start = len
-	}
-	if end > len {
-		end = len
-	}
-	if start > end {
-		start, end = end, start
-	}
-
+	start, end = sliceBounds(len(l.Elements), start, end)
 	return &List{Elements: append([]interface{}{}, l.Elements[start:end]...)}
 }
 
@@ -170,9 +158,42 @@ func (l *List) Clear() *List {
 }
 
 func (l *List) String() string {
+	if reprStrings.Load() {
+		return Repr(l)
+	}
 	strElems := make([]string, len(l.Elements))
 	for i, e := range l.Elements {
 		strElems[i] = fmt.Sprintf("%v", e)
 	}
 	return "[" + strings.Join(strElems, ", ") + "]"
 }
+
+func clampIndex(length, index int) int {
+	if index < 0 {
+		index = length + index
+		if index < 0 {
+			index = 0
+		}
+	}
+	if index > length {
+		index = length
+	}
+	return index
+}
+
+func sliceBounds(length, start, end int) (int, int) {
+	start = clampIndex(length, start)
+	end = clampIndex(length, end)
+	if end < start {
+		end = start
+	}
+	return start, end
+}
+
+func permute(elements []interface{}, order []int) []interface{} {
+	result := make([]interface{}, len(order))
+	for i, j := range order {
+		result[i] = elements[j]
+	}
+	return result
+}
@@ -232,6 +232,76 @@ func TestSort(t *testing.T) {
 	}
 }
 
+// Test | SortBy verifies sorting with a key function and reverse flag while keeping equal elements in order
+func TestSortBy(t *testing.T) {
+	words := New("bb", "a", "ccc", "dd", "e")
+	calls := 0
+	err := words.SortBy(func(e interface{}) interface{} {
+		calls++
+		return len(e.(string))
+	}, false)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if calls != 5 {
+		t.Errorf("Expected key function to be called once per element, got %d calls", calls)
+	}
+
+	expected := []interface{}{"a", "e", "bb", "dd", "ccc"}
+	for i, v := range expected {
+		if words.Elements[i] != v {
+			t.Errorf("Expected %v at index %d, got %v", v, i, words.Elements[i])
+		}
+	}
+
+	// Reverse keeps the original order of equal keys
+	err = words.SortBy(func(e interface{}) interface{} { return len(e.(string)) }, true)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected = []interface{}{"ccc", "bb", "dd", "a", "e"}
+	for i, v := range expected {
+		if words.Elements[i] != v {
+			t.Errorf("Expected %v at index %d, got %v", v, i, words.Elements[i])
+		}
+	}
+
+	// Nil key sorts by the elements themselves
+	nums := New(2, 3, 1)
+	nums.SortBy(nil, true)
+	expected = []interface{}{3, 2, 1}
+	for i, v := range expected {
+		if nums.Elements[i] != v {
+			t.Errorf("Expected %v at index %d, got %v", v, i, nums.Elements[i])
+		}
+	}
+
+	// Incomparable keys leave the list unchanged
+	mixed := New(2, "a", 1)
+	err = mixed.SortBy(func(e interface{}) interface{} { return e }, false)
+	if err == nil {
+		t.Error("Expected error when sorting by incomparable keys, got nil")
+	}
+	if mixed.Elements[0] != 2 || mixed.Elements[1] != "a" {
+		t.Errorf("Expected list to be unchanged after failed sort, got %v", mixed)
+	}
+}
+
+// Test | SortFunc verifies stable sorting with a custom less function
+func TestSortFunc(t *testing.T) {
+	pairs := New([]interface{}{1, "b"}, []interface{}{0, "a"}, []interface{}{1, "a"}, []interface{}{0, "b"})
+	pairs.SortFunc(func(a, b interface{}) bool {
+		return a.([]interface{})[0].(int) < b.([]interface{})[0].(int)
+	})
+
+	expected := []string{"a", "b", "b", "a"}
+	for i, v := range expected {
+		if pairs.Elements[i].([]interface{})[1] != v {
+			t.Errorf("Expected %v at index %d, got %v", v, i, pairs.Elements[i])
+		}
+	}
+}
+
 // Test | Reverse verifies reversing the order of elements in a list
 func TestReverse(t *testing.T) {
 	list := New(1, 2, 3, 4)
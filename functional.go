@@ -0,0 +1,477 @@
+package ezarr
+
+import (
+	"fmt"
+	"iter"
+	"math"
+	"math/big"
+	"reflect"
+)
+
+// The package functions mirror Python's built-ins and accept any
+// iter.Seq, such as list.Values(), set.All() or dict.IterKeys(). Map,
+// Filter, Enumerate and Zip are lazy like their Python counterparts; the
+// List methods of the same names return a new List instead.
+
+func Map(f func(interface{}) interface{}, seq iter.Seq[interface{}]) iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter yields the elements for which f is true. A nil f tests the
+// elements themselves with Truth.
+func Filter(f func(interface{}) bool, seq iter.Seq[interface{}]) iter.Seq[interface{}] {
+	if f == nil {
+		f = Truth
+	}
+	return func(yield func(interface{}) bool) {
+		for v := range seq {
+			if f(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate yields each element with a count that begins at start.
+func Enumerate(seq iter.Seq[interface{}], start int) iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		i := start
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Zip yields Tuples of the elements of seqs at each position and stops at
+// the shortest, with a nil error. With strict set, sequences of different
+// lengths end it with a nil Tuple and a *ValueError once the shortest runs
+// out, as Python raises one from zip(..., strict=True).
+func Zip(strict bool, seqs ...iter.Seq[interface{}]) iter.Seq2[interface{}, error] {
+	return func(yield func(interface{}, error) bool) {
+		if len(seqs) == 0 {
+			return
+		}
+		nexts := make([]func() (interface{}, bool), len(seqs))
+		for i, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			defer stop()
+			nexts[i] = next
+		}
+
+		for {
+			row := make([]interface{}, len(seqs))
+			for i, next := range nexts {
+				v, ok := next()
+				if ok {
+					row[i] = v
+					continue
+				}
+				if !strict {
+					return
+				}
+				if i > 0 {
+					yield(nil, zipLengthError(i, true))
+					return
+				}
+				for j := 1; j < len(nexts); j++ {
+					if _, ok := nexts[j](); ok {
+						yield(nil, zipLengthError(j, false))
+						return
+					}
+				}
+				return
+			}
+			if !yield(Tuple{elements: row}, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Reduce combines the elements from left to right with f, starting from
+// initial when it is given. It returns a TypeError for an empty sequence
+// without an initial value.
+func Reduce(f func(total, element interface{}) interface{}, seq iter.Seq[interface{}], initial ...interface{}) (interface{}, error) {
+	total, ok, err := optional("Reduce", "initial value", initial)
+	if err != nil {
+		return nil, err
+	}
+	for v := range seq {
+		if ok {
+			total = f(total, v)
+		} else {
+			total, ok = v, true
+		}
+	}
+	if !ok {
+		return nil, &TypeError{Msg: "reduce() of empty iterable with no initial value"}
+	}
+	return total, nil
+}
+
+// Any reports whether any element is true, as decided by Truth.
+func Any(seq iter.Seq[interface{}]) bool {
+	for v := range seq {
+		if Truth(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// All reports whether every element is true, as decided by Truth.
+func All(seq iter.Seq[interface{}]) bool {
+	for v := range seq {
+		if !Truth(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Sum adds the elements to start following Python's numeric rules: ints of
+// any Go kind add exactly and overflow into *big.Int, a float turns the sum
+// into a float64 that is added with compensation like Python 3.12, and a
+// complex number turns it into a complex128. A *List or Tuple start
+// concatenates elements of the same type. Strings cannot be summed.
+func Sum(seq iter.Seq[interface{}], start interface{}) (interface{}, error) {
+	switch s := start.(type) {
+	case string, []byte:
+		return nil, &TypeError{Value: start, Msg: "sum() can't sum strings [use strings.Join instead]"}
+	case *List:
+		result := s.Copy()
+		for v := range seq {
+			l, ok := v.(*List)
+			if !ok {
+				return nil, sumTypeError(result, v)
+			}
+			result.Extend(l)
+		}
+		return result, nil
+	case Tuple:
+		elements := append([]interface{}{}, s.elements...)
+		for v := range seq {
+			t, ok := v.(Tuple)
+			if !ok {
+				return nil, sumTypeError(s, v)
+			}
+			elements = append(elements, t.elements...)
+		}
+		return Tuple{elements: elements}, nil
+	}
+
+	var total numberSum
+	startErr := total.add(start)
+	added := false
+	for v := range seq {
+		if startErr != nil {
+			return nil, sumTypeError(start, v)
+		}
+		if err := total.add(v); err != nil {
+			return nil, sumTypeError(total.result(), v)
+		}
+		added = true
+	}
+	if !added {
+		return start, nil
+	}
+	return total.result(), nil
+}
+
+// Min returns the smallest element, or the smallest by key(element) when
+// key is not nil; the first of equal elements wins. An empty sequence
+// returns defaultValue when one is given and a ValueError otherwise.
+func Min(seq iter.Seq[interface{}], key func(interface{}) interface{}, defaultValue ...interface{}) (interface{}, error) {
+	return extreme("min", seq, key, -1, defaultValue)
+}
+
+// Max returns the largest element, or the largest by key(element) when
+// key is not nil; the first of equal elements wins. An empty sequence
+// returns defaultValue when one is given and a ValueError otherwise.
+func Max(seq iter.Seq[interface{}], key func(interface{}) interface{}, defaultValue ...interface{}) (interface{}, error) {
+	return extreme("max", seq, key, 1, defaultValue)
+}
+
+// Sorted returns a new List of the elements sorted like List.SortBy.
+func Sorted(seq iter.Seq[interface{}], key func(interface{}) interface{}, reverse bool) (*List, error) {
+	result := Collect(seq)
+	if err := result.SortBy(key, reverse); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (l *List) Map(f func(interface{}) interface{}) *List {
+	elements := make([]interface{}, len(l.Elements))
+	for i, e := range l.Elements {
+		elements[i] = f(e)
+	}
+	return &List{Elements: elements}
+}
+
+// Filter returns the elements for which f is true. A nil f tests the
+// elements themselves with Truth.
+func (l *List) Filter(f func(interface{}) bool) *List {
+	return Collect(Filter(f, l.Values()))
+}
+
+func (l *List) Reduce(f func(total, element interface{}) interface{}, initial ...interface{}) (interface{}, error) {
+	return Reduce(f, l.Values(), initial...)
+}
+
+// Enumerate returns (index, element) Tuples with indexes counted from
+// start.
+func (l *List) Enumerate(start int) *List {
+	elements := make([]interface{}, len(l.Elements))
+	for i, e := range l.Elements {
+		elements[i] = NewTuple(start+i, e)
+	}
+	return &List{Elements: elements}
+}
+
+// Zip returns Tuples of the elements of l and others at each position,
+// stopping at the shortest List. With strict set, Lists of different
+// lengths return a ValueError instead.
+func (l *List) Zip(strict bool, others ...*List) (*List, error) {
+	lists := append([]*List{l}, others...)
+	n := len(l.Elements)
+	for i, other := range others {
+		switch {
+		case len(other.Elements) == len(l.Elements):
+			continue
+		case strict:
+			return nil, zipLengthError(i+1, len(other.Elements) < len(l.Elements))
+		case len(other.Elements) < n:
+			n = len(other.Elements)
+		}
+	}
+
+	elements := make([]interface{}, n)
+	for i := range elements {
+		row := make([]interface{}, len(lists))
+		for j, list := range lists {
+			row[j] = list.Elements[i]
+		}
+		elements[i] = Tuple{elements: row}
+	}
+	return &List{Elements: elements}, nil
+}
+
+// Any reports whether any element is true, as decided by Truth. The
+// matching check for every element is the package function All, since
+// List.All is the iterator.
+func (l *List) Any() bool {
+	return Any(l.Values())
+}
+
+func (l *List) Sum(start interface{}) (interface{}, error) {
+	return Sum(l.Values(), start)
+}
+
+func (l *List) Min(key func(interface{}) interface{}, defaultValue ...interface{}) (interface{}, error) {
+	return Min(l.Values(), key, defaultValue...)
+}
+
+func (l *List) Max(key func(interface{}) interface{}, defaultValue ...interface{}) (interface{}, error) {
+	return Max(l.Values(), key, defaultValue...)
+}
+
+func (l *List) Sorted(key func(interface{}) interface{}, reverse bool) (*List, error) {
+	return Sorted(l.Values(), key, reverse)
+}
+
+func extreme(name string, seq iter.Seq[interface{}], key func(interface{}) interface{}, sign int, defaultValue []interface{}) (interface{}, error) {
+	fallback, hasDefault, err := optional(name, "default", defaultValue)
+	if err != nil {
+		return nil, err
+	}
+
+	var best, bestKey interface{}
+	found := false
+	for v := range seq {
+		k := v
+		if key != nil {
+			k = key(v)
+		}
+		if !found {
+			best, bestKey, found = v, k, true
+			continue
+		}
+		c, err := Compare(k, bestKey)
+		if err != nil {
+			return nil, err
+		}
+		if c*sign > 0 {
+			best, bestKey = v, k
+		}
+	}
+
+	if !found {
+		if hasDefault {
+			return fallback, nil
+		}
+		return nil, &ValueError{Msg: name + "() iterable argument is empty"}
+	}
+	return best, nil
+}
+
+// optional unpacks a variadic argument that stands for an optional one.
+func optional(function, name string, values []interface{}) (interface{}, bool, error) {
+	switch len(values) {
+	case 0:
+		return nil, false, nil
+	case 1:
+		return values[0], true, nil
+	}
+	return nil, false, &TypeError{Value: len(values), Msg: fmt.Sprintf("%s takes at most one %s", function, name)}
+}
+
+// zipLengthError reports that argument i, counted from zero, is shorter or
+// longer than the ones before it, in the words of Python's zip.
+func zipLengthError(i int, shorter bool) error {
+	comparison := "longer"
+	if shorter {
+		comparison = "shorter"
+	}
+	previous := "argument 1"
+	if i > 1 {
+		previous = fmt.Sprintf("arguments 1-%d", i)
+	}
+	return &ValueError{Value: i + 1, Msg: fmt.Sprintf("zip() argument %d is %s than %s", i+1, comparison, previous)}
+}
+
+func sumTypeError(total, v interface{}) error {
+	return &TypeError{
+		Value: v,
+		Msg:   fmt.Sprintf("unsupported operand type(s) for +: '%s' and '%s'", pyTypeName(total), pyTypeName(v)),
+	}
+}
+
+const (
+	sumInt = iota
+	sumFloat
+	sumComplex
+)
+
+// numberSum accumulates a sum the way Python's sum does: exactly while
+// every value is an integer, then as a float with Neumaier compensation,
+// then as a complex number.
+type numberSum struct {
+	kind int
+	i    int
+	big  *big.Int
+	f, c float64
+	z    complex128
+}
+
+func (s *numberSum) add(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if n, ok := v.(*big.Int); ok {
+		s.addInt(n)
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n := numberInt(rv)
+		if s.kind == sumInt && s.big == nil && n.IsInt64() && int64(int(n.Int64())) == n.Int64() {
+			x := int(n.Int64())
+			if sum := s.i + x; (x >= 0) == (sum >= s.i) {
+				s.i = sum
+				return nil
+			}
+		}
+		s.addInt(n)
+	case reflect.Float32, reflect.Float64:
+		s.addFloat(rv.Float())
+	case reflect.Complex64, reflect.Complex128:
+		s.toComplex()
+		s.z += rv.Complex()
+	default:
+		return &TypeError{Value: v}
+	}
+	return nil
+}
+
+func (s *numberSum) addInt(n *big.Int) {
+	switch s.kind {
+	case sumInt:
+		if s.big == nil {
+			s.big = big.NewInt(int64(s.i))
+		}
+		s.big.Add(s.big, n)
+	case sumFloat:
+		f, _ := new(big.Float).SetInt(n).Float64()
+		s.addFloat(f)
+	case sumComplex:
+		f, _ := new(big.Float).SetInt(n).Float64()
+		s.z += complex(f, 0)
+	}
+}
+
+func (s *numberSum) addFloat(x float64) {
+	switch s.kind {
+	case sumInt:
+		s.f, s.c = s.intFloat(), 0
+		s.kind = sumFloat
+	case sumComplex:
+		s.z += complex(x, 0)
+		return
+	}
+
+	t := s.f + x
+	if math.Abs(s.f) >= math.Abs(x) {
+		s.c += (s.f - t) + x
+	} else {
+		s.c += (x - t) + s.f
+	}
+	s.f = t
+}
+
+func (s *numberSum) toComplex() {
+	switch s.kind {
+	case sumInt:
+		s.z = complex(s.intFloat(), 0)
+	case sumFloat:
+		s.z = complex(s.floatResult(), 0)
+	}
+	s.kind = sumComplex
+}
+
+func (s *numberSum) intFloat() float64 {
+	if s.big == nil {
+		return float64(s.i)
+	}
+	f, _ := new(big.Float).SetInt(s.big).Float64()
+	return f
+}
+
+func (s *numberSum) floatResult() float64 {
+	if math.IsInf(s.f, 0) || math.IsNaN(s.f) {
+		return s.f
+	}
+	return s.f + s.c
+}
+
+func (s *numberSum) result() interface{} {
+	switch s.kind {
+	case sumFloat:
+		return s.floatResult()
+	case sumComplex:
+		return s.z
+	}
+	if s.big != nil {
+		return normalizeInt(s.big)
+	}
+	return s.i
+}
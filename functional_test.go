@@ -0,0 +1,176 @@
+package ezarr
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// Test | Map, Filter, Enumerate and Reduce verify List methods and lazy package functions
+func TestMapFilterReduce(t *testing.T) {
+	l := New(1, 2, 3, 4)
+	double := func(v interface{}) interface{} { return v.(int) * 2 }
+	even := func(v interface{}) bool { return v.(int)%2 == 0 }
+
+	if got := l.Map(double); !Equal(got, New(2, 4, 6, 8)) {
+		t.Errorf("Expected [2, 4, 6, 8], got %v", got)
+	}
+	if got := l.Filter(even); !Equal(got, New(2, 4)) {
+		t.Errorf("Expected [2, 4], got %v", got)
+	}
+	if got := New(0, 1, "", "a", nil, New()).Filter(nil); !Equal(got, New(1, "a")) {
+		t.Errorf("Expected a nil filter to keep true elements, got %v", got)
+	}
+	if got := Collect(Map(double, Filter(even, l.Values()))); !Equal(got, New(4, 8)) {
+		t.Errorf("Expected [4, 8], got %v", got)
+	}
+	if Repr(l.Enumerate(1).Slice(0, 2)) != "[(1, 1), (2, 2)]" {
+		t.Errorf("Unexpected enumeration: %s", Repr(l.Enumerate(1)))
+	}
+	for i, v := range Enumerate(New("a").Values(), 5) {
+		if i != 5 || v != "a" {
+			t.Errorf("Expected (5, a), got (%d, %v)", i, v)
+		}
+	}
+
+	calls := 0
+	lazy := Map(func(v interface{}) interface{} { calls++; return v }, RangeTo(1000).ToList().Values())
+	for range lazy {
+		break
+	}
+	if calls != 1 {
+		t.Errorf("Expected Map to be lazy, got %d calls", calls)
+	}
+
+	multiply := func(a, b interface{}) interface{} { return a.(int) * b.(int) }
+	if v, err := l.Reduce(multiply); err != nil || v != 24 {
+		t.Errorf("Expected 24, got %v, error: %v", v, err)
+	}
+	if v, err := New().Reduce(multiply, 1); err != nil || v != 1 {
+		t.Errorf("Expected the initial value, got %v, error: %v", v, err)
+	}
+	if _, err := New().Reduce(multiply); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError for an empty list, got %v", err)
+	}
+	if _, err := l.Reduce(multiply, 1, 2); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError for two initial values, got %v", err)
+	}
+}
+
+// Test | Zip verifies truncation and strict length checks against Python messages
+func TestZip(t *testing.T) {
+	a, b := New(1, 2, 3), New("x", "y")
+
+	if got, err := a.Zip(false, b); err != nil || Repr(got) != "[(1, 'x'), (2, 'y')]" {
+		t.Errorf("Expected [(1, 'x'), (2, 'y')], got %s, error: %v", Repr(got), err)
+	}
+	_, err := a.Zip(true, b)
+	if !errors.Is(err, ErrValue) || err.Error() != "zip() argument 2 is shorter than argument 1" {
+		t.Errorf("Expected a shorter argument ValueError, got %v", err)
+	}
+	_, err = New(1).Zip(true, New(1), New(1, 2))
+	if err == nil || err.Error() != "zip() argument 3 is longer than arguments 1-2" {
+		t.Errorf("Expected a longer argument ValueError, got %v", err)
+	}
+
+	pairs := 0
+	for pair, err := range Zip(false, a.Values(), b.Values()) {
+		if err != nil || pair.(Tuple).Len() != 2 {
+			t.Errorf("Expected a pair and no error, got %v, error: %v", pair, err)
+		}
+		pairs++
+	}
+	if pairs != 2 {
+		t.Errorf("Expected 2 pairs, got %d", pairs)
+	}
+
+	pairs, err = 0, nil
+	for pair, e := range Zip(true, b.Values(), a.Values()) {
+		if e != nil {
+			if pair != nil {
+				t.Errorf("Expected a nil Tuple with the error, got %v", pair)
+			}
+			err = e
+			continue
+		}
+		pairs++
+	}
+	if pairs != 2 || !errors.Is(err, ErrValue) || err.Error() != "zip() argument 2 is longer than argument 1" {
+		t.Errorf("Expected 2 pairs then a longer argument ValueError, got %d pairs, error: %v", pairs, err)
+	}
+}
+
+// Test | Any, All, Min, Max and Sorted verify Python results
+func TestAnyAllMinMax(t *testing.T) {
+	if !New(0, "", 1).Any() || New(0, "").Any() || !All(New(1, "a").Values()) || All(New(1, 0).Values()) || !All(New().Values()) {
+		t.Error("Unexpected Any or All result")
+	}
+
+	words := New("bb", "a", "cc", "d")
+	byLen := func(v interface{}) interface{} { return len(v.(string)) }
+	if v, _ := words.Max(byLen); v != "bb" {
+		t.Errorf("Expected the first longest word, got %v", v)
+	}
+	if v, _ := words.Min(byLen); v != "a" {
+		t.Errorf("Expected the first shortest word, got %v", v)
+	}
+	if v, _ := New(3, 1.5, 2).Min(nil); v != 1.5 {
+		t.Errorf("Expected 1.5, got %v", v)
+	}
+	if v, err := New().Max(nil, "none"); err != nil || v != "none" {
+		t.Errorf("Expected the default, got %v, error: %v", v, err)
+	}
+	if _, err := New().Min(nil); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError for an empty list, got %v", err)
+	}
+	if _, err := New(1, "a").Max(nil); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError for incomparable elements, got %v", err)
+	}
+
+	sorted, err := words.Sorted(byLen, true)
+	if err != nil || !Equal(sorted, New("bb", "cc", "a", "d")) || !Equal(words, New("bb", "a", "cc", "d")) {
+		t.Errorf("Expected a stable reverse sort without changing the list, got %v, error: %v", sorted, err)
+	}
+}
+
+// Test | Sum verifies Python's numeric coercion
+func TestSum(t *testing.T) {
+	overflow, _ := new(big.Int).SetString("9223372036854775808", 10)
+	cases := []struct {
+		name     string
+		list     *List
+		start    interface{}
+		expected interface{}
+	}{
+		{"ints", New(1, 2, 3), 0, 6},
+		{"mixed kinds", New(int8(1), uint16(2), true), 0, 4},
+		{"float", New(1, 2.5), 0, 3.5},
+		{"compensated", New(0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1), 0, 1.0},
+		{"cancellation", New(1e100, 1.0, -1e100), 0, 1.0},
+		{"complex", New(1, 2.5, 1i), 0, complex(3.5, 1)},
+		{"overflow", New(math.MaxInt, 1), 0, overflow},
+		{"back to int", New(math.MaxInt, 1, -1), 0, math.MaxInt},
+		{"start", New(1), 10, 11},
+		{"empty", New(), nil, nil},
+		{"lists", New(New(1), New(2, 3)), New(), New(1, 2, 3)},
+		{"tuples", New(NewTuple(1), NewTuple(2)), NewTuple(0), NewTuple(0, 1, 2)},
+	}
+	for _, c := range cases {
+		got, err := c.list.Sum(c.start)
+		if err != nil || !Equal(got, c.expected) {
+			t.Errorf("%s: expected %v (%T), got %v (%T), error: %v", c.name, c.expected, c.expected, got, got, err)
+		}
+	}
+
+	_, err := New(1, "a").Sum(0)
+	if !errors.Is(err, ErrType) || err.Error() != "unsupported operand type(s) for +: 'int' and 'str'" {
+		t.Errorf("Expected TypeError adding a string, got %v", err)
+	}
+	if _, err := New("a").Sum(""); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError summing strings, got %v", err)
+	}
+	if v, _ := Sum(NewSet(1, 2).All(), 0); v != 3 {
+		t.Errorf("Expected Sum to accept any sequence, got %v", v)
+	}
+}
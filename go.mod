@@ -1,3 +1,3 @@
 module github.com/NovaDAndrew/ezarr
 
-go 1.20
\ No newline at end of file
+go 1.23
\ No newline at end of file
@@ -0,0 +1,544 @@
+package ezarr
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// Hasher lets a type supply its own hash. Two values that are Equal must
+// return the same hash.
+type Hasher interface {
+	Hash() uint64
+}
+
+// elementHasher is implemented by the containers that Hash and Equal look
+// at through their elements instead of their fields, so that caches and
+// internal layout do not matter. Elements that are not ordered match in any
+// order, as in a set.
+type elementHasher interface {
+	hashElements() (elements []interface{}, ordered bool)
+}
+
+var (
+	hashSeed          = maphash.MakeSeed()
+	hasherType        = reflect.TypeOf((*Hasher)(nil)).Elem()
+	elementHasherType = reflect.TypeOf((*elementHasher)(nil)).Elem()
+	tupleType         = reflect.TypeOf(Tuple{})
+)
+
+// Hash returns a structural hash of v that is consistent with Equal.
+func Hash(v interface{}) uint64 {
+	switch v := v.(type) {
+	case nil:
+		return 0
+	case string:
+		return maphash.String(hashSeed, v)
+	case int:
+		return hashUint(uint64(reflect.Int), uint64(v))
+	case Hasher:
+		return v.Hash()
+	}
+
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	writeHash(&h, reflect.ValueOf(v), newHashState())
+	return h.Sum64()
+}
+
+// Equal reports whether a and b are deeply equal. It follows the rules of
+// reflect.DeepEqual, except that Lists and Dicts are compared by their
+// elements only: a nil and an empty Elements slice are equal, and the lookup
+// index a Dict caches and the Factory of a DefaultDict do not affect the
+// result. Sets are equal when they hold the same elements in any order, and
+// Deques when they hold the same elements from left to right.
+func Equal(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return deepEqual(reflect.ValueOf(a), reflect.ValueOf(b), map[visit]bool{})
+}
+
+type visit struct {
+	a1, a2 unsafe.Pointer
+	n      int
+	typ    reflect.Type
+}
+
+func hashUint(kind, x uint64) uint64 {
+	var buf [16]byte
+	binary.LittleEndian.PutUint64(buf[:8], kind)
+	binary.LittleEndian.PutUint64(buf[8:], x)
+	return maphash.Bytes(hashSeed, buf[:])
+}
+
+func writeUint(h *maphash.Hash, x uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], x)
+	h.Write(buf[:])
+}
+
+// hashState is shared by the calls that make up one Hash. active holds the
+// containers on the current path, to cut cycles, and done the hash of each
+// container already finished, so that an object shared by several others is
+// hashed once. Containers whose hash depended on a cut cycle are not kept in
+// done, since their hash depends on the path they were reached by.
+type hashState struct {
+	active map[visit]bool
+	done   map[visit]uint64
+	cuts   int
+}
+
+func newHashState() *hashState {
+	return &hashState{active: map[visit]bool{}, done: map[visit]uint64{}}
+}
+
+// writeContainer writes the hash of the pointer, slice or map v, which
+// write computes the first time v is reached.
+func (s *hashState) writeContainer(h *maphash.Hash, v reflect.Value, write func(*maphash.Hash)) {
+	key, cyclic := enter(v, s.active)
+	if cyclic {
+		s.cuts++
+		return
+	}
+	defer delete(s.active, key)
+
+	sum, ok := s.done[key]
+	if !ok {
+		cuts := s.cuts
+		var sub maphash.Hash
+		sub.SetSeed(hashSeed)
+		write(&sub)
+		sum = sub.Sum64()
+		if s.cuts == cuts {
+			s.done[key] = sum
+		}
+	}
+	writeUint(h, sum)
+}
+
+func writeHash(h *maphash.Hash, v reflect.Value, visited *hashState) {
+	if !v.IsValid() {
+		h.WriteByte(0)
+		return
+	}
+
+	if v.Type().Implements(hasherType) && v.CanInterface() {
+		if v.Kind() != reflect.Pointer && v.Kind() != reflect.Interface || !v.IsNil() {
+			h.WriteByte(1)
+			writeUint(h, v.Interface().(Hasher).Hash())
+			return
+		}
+	}
+
+	kind := v.Kind()
+	h.WriteByte(byte(kind))
+
+	switch kind {
+	case reflect.Bool:
+		if v.Bool() {
+			h.WriteByte(1)
+		} else {
+			h.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(h, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(h, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeUint(h, floatBits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeUint(h, floatBits(real(c)))
+		writeUint(h, floatBits(imag(c)))
+	case reflect.String:
+		h.WriteString(v.String())
+	case reflect.Chan, reflect.UnsafePointer:
+		writeUint(h, uint64(uintptr(v.UnsafePointer())))
+	case reflect.Func:
+		if v.IsNil() {
+			h.WriteByte(0)
+		} else {
+			h.WriteByte(1)
+		}
+	case reflect.Interface:
+		writeHash(h, exported(v).Elem(), visited)
+	case reflect.Pointer:
+		if v.IsNil() {
+			h.WriteByte(0)
+			return
+		}
+		visited.writeContainer(h, v, func(h *maphash.Hash) {
+			writeHash(h, v.Elem(), visited)
+		})
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeHash(h, v.Index(i), visited)
+		}
+	case reflect.Slice:
+		if v.IsNil() {
+			h.WriteByte(0)
+			return
+		}
+		writeUint(h, uint64(v.Len()))
+		visited.writeContainer(h, v, func(h *maphash.Hash) {
+			for i := 0; i < v.Len(); i++ {
+				writeHash(h, v.Index(i), visited)
+			}
+		})
+	case reflect.Map:
+		if v.IsNil() {
+			h.WriteByte(0)
+			return
+		}
+		writeUint(h, uint64(v.Len()))
+		visited.writeContainer(h, v, func(h *maphash.Hash) {
+			// Map iteration order is random, so entries are hashed on
+			// their own and combined with an order-independent sum.
+			var sum uint64
+			iter := v.MapRange()
+			for iter.Next() {
+				var entry maphash.Hash
+				entry.SetSeed(hashSeed)
+				writeHash(&entry, iter.Key(), visited)
+				writeHash(&entry, iter.Value(), visited)
+				sum += entry.Sum64()
+			}
+			writeUint(h, sum)
+		})
+	case reflect.Struct:
+		if elements, ordered, ok := containerElements(v); ok {
+			writeUint(h, uint64(len(elements)))
+			if !ordered {
+				// Set elements are hashed on their own and combined with
+				// an order-independent sum, like the entries of a map.
+				var sum uint64
+				for _, e := range elements {
+					sum += elementHash(reflect.ValueOf(e), visited)
+				}
+				writeUint(h, sum)
+				return
+			}
+			for _, e := range elements {
+				writeHash(h, reflect.ValueOf(e), visited)
+			}
+			return
+		}
+		v = addressable(v)
+		for i := 0; i < v.NumField(); i++ {
+			writeHash(h, v.Field(i), visited)
+		}
+	}
+}
+
+func elementHash(v reflect.Value, visited *hashState) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	writeHash(&h, v, visited)
+	return h.Sum64()
+}
+
+func floatBits(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+	return math.Float64bits(f)
+}
+
+func deepEqual(v1, v2 reflect.Value, visited map[visit]bool) bool {
+	if !v1.IsValid() || !v2.IsValid() {
+		return v1.IsValid() == v2.IsValid()
+	}
+	if v1.Type() != v2.Type() {
+		return false
+	}
+
+	switch v1.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if !v1.IsNil() && !v2.IsNil() {
+			key := visit{a1: v1.UnsafePointer(), a2: v2.UnsafePointer(), typ: v1.Type()}
+			if v1.Kind() == reflect.Slice {
+				key.n = v1.Len()
+			}
+			if visited[key] {
+				return true
+			}
+			visited[key] = true
+		}
+	}
+
+	switch v1.Kind() {
+	case reflect.Array:
+		for i := 0; i < v1.Len(); i++ {
+			if !deepEqual(v1.Index(i), v2.Index(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice:
+		if v1.IsNil() != v2.IsNil() {
+			return false
+		}
+		if v1.Len() != v2.Len() {
+			return false
+		}
+		if v1.UnsafePointer() == v2.UnsafePointer() {
+			return true
+		}
+		for i := 0; i < v1.Len(); i++ {
+			if !deepEqual(v1.Index(i), v2.Index(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Interface:
+		if v1.IsNil() || v2.IsNil() {
+			return v1.IsNil() == v2.IsNil()
+		}
+		return deepEqual(exported(v1).Elem(), exported(v2).Elem(), visited)
+	case reflect.Pointer:
+		if v1.UnsafePointer() == v2.UnsafePointer() {
+			return true
+		}
+		return deepEqual(v1.Elem(), v2.Elem(), visited)
+	case reflect.Struct:
+		if e1, ordered, ok := containerElements(v1); ok {
+			if e2, _, ok := containerElements(v2); ok {
+				if len(e1) != len(e2) {
+					return false
+				}
+				if !ordered {
+					return setElementsEqual(e1, e2, visited)
+				}
+				for i := range e1 {
+					if !deepEqual(reflect.ValueOf(e1[i]), reflect.ValueOf(e2[i]), visited) {
+						return false
+					}
+				}
+				return true
+			}
+		}
+		v1, v2 = addressable(v1), addressable(v2)
+		for i := 0; i < v1.NumField(); i++ {
+			if !deepEqual(v1.Field(i), v2.Field(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if v1.IsNil() != v2.IsNil() {
+			return false
+		}
+		if v1.Len() != v2.Len() {
+			return false
+		}
+		if v1.UnsafePointer() == v2.UnsafePointer() {
+			return true
+		}
+		iter := v1.MapRange()
+		for iter.Next() {
+			val2 := v2.MapIndex(iter.Key())
+			if !val2.IsValid() || !deepEqual(iter.Value(), val2, visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func:
+		return v1.IsNil() && v2.IsNil()
+	case reflect.Bool:
+		return v1.Bool() == v2.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v1.Int() == v2.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v1.Uint() == v2.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v1.Float() == v2.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return v1.Complex() == v2.Complex()
+	case reflect.String:
+		return v1.String() == v2.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return v1.UnsafePointer() == v2.UnsafePointer()
+	}
+	return false
+}
+
+// containerElements returns the elements of v when its type implements
+// elementHasher. ok is false for a container that cannot be read because it
+// sits in an unexported field that is not addressable, such as a map value;
+// it is then compared field by field.
+func containerElements(v reflect.Value) (elements []interface{}, ordered, ok bool) {
+	if !v.Type().Implements(elementHasherType) {
+		return nil, false, false
+	}
+	if v = exported(v); !v.CanInterface() {
+		return nil, false, false
+	}
+	elements, ordered = v.Interface().(elementHasher).hashElements()
+	return elements, ordered, true
+}
+
+// exported returns v read through its address when it was reached through
+// an unexported struct field, so that Interface can be called on it.
+func exported(v reflect.Value) reflect.Value {
+	if v.CanInterface() || !v.CanAddr() {
+		return v
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
+
+// addressable returns an addressable copy of a struct that is not, so that
+// the containers in its unexported fields can be read by containerElements.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() || !v.CanInterface() {
+		return v
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+// setElementsEqual matches the elements of two sets of the same length by
+// hash, so the result does not depend on insertion order.
+func setElementsEqual(e1, e2 []interface{}, visited map[visit]bool) bool {
+	buckets := make(map[uint64][]int, len(e2))
+	for j, e := range e2 {
+		h := elementHash(reflect.ValueOf(e), newHashState())
+		buckets[h] = append(buckets[h], j)
+	}
+	for _, e := range e1 {
+		found := false
+		for _, j := range buckets[elementHash(reflect.ValueOf(e), newHashState())] {
+			if deepEqual(reflect.ValueOf(e), reflect.ValueOf(e2[j]), visited) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// dictIndex maps key hashes to positions in Dict.Keys. It keeps its own
+// copy of the keys it was built for, so that a Keys slice changed outside of
+// Dict's methods, in place or not, is noticed before a miss is trusted. An
+// empty index is the zero value, as for an empty Dict that was never written.
+type dictIndex struct {
+	buckets map[uint64][]int
+	keys    []interface{}
+}
+
+// find returns the position of key in keys according to the index, or -1.
+// Positions are checked with Equal, so a stale index can miss but never
+// return a wrong position.
+func (idx *dictIndex) find(h uint64, key interface{}, keys []interface{}) int {
+	for _, i := range idx.buckets[h] {
+		if i < len(keys) && Equal(keys[i], key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// current reports whether keys still holds the very values the index was
+// built for. It compares the interface words, not the values, so it is a
+// plain memory comparison.
+func (idx *dictIndex) current(keys []interface{}) bool {
+	if len(idx.keys) != len(keys) {
+		return false
+	}
+	if len(keys) == 0 {
+		return true
+	}
+	size := len(keys) * int(unsafe.Sizeof(keys[0]))
+	return bytes.Equal(
+		unsafe.Slice((*byte)(unsafe.Pointer(&idx.keys[0])), size),
+		unsafe.Slice((*byte)(unsafe.Pointer(&keys[0])), size),
+	)
+}
+
+func (idx *dictIndex) rebuild(keys []interface{}) {
+	*idx = dictIndex{}
+	if len(keys) == 0 {
+		return
+	}
+	idx.buckets = make(map[uint64][]int, len(keys))
+	idx.keys = make([]interface{}, len(keys))
+	copy(idx.keys, keys)
+	for i, key := range keys {
+		h := Hash(key)
+		idx.buckets[h] = append(idx.buckets[h], i)
+	}
+}
+
+// add records key, with hash h, as appended to the end of the keys.
+func (idx *dictIndex) add(h uint64, key interface{}) {
+	if idx.buckets == nil {
+		idx.buckets = map[uint64][]int{}
+	}
+	idx.buckets[h] = append(idx.buckets[h], len(idx.keys))
+	idx.keys = append(idx.keys, key)
+}
+
+// remove drops the key at position index, with hash h, shifting the
+// positions that followed it.
+func (idx *dictIndex) remove(h uint64, index int) {
+	if len(idx.keys) == 1 {
+		*idx = dictIndex{}
+		return
+	}
+
+	bucket := idx.buckets[h]
+	for j, i := range bucket {
+		if i == index {
+			bucket = append(bucket[:j], bucket[j+1:]...)
+			break
+		}
+	}
+	if len(bucket) == 0 {
+		delete(idx.buckets, h)
+	} else {
+		idx.buckets[h] = bucket
+	}
+
+	if index < len(idx.keys)-1 {
+		for _, positions := range idx.buckets {
+			for j, i := range positions {
+				if i > index {
+					positions[j] = i - 1
+				}
+			}
+		}
+	}
+	idx.keys = append(idx.keys[:index], idx.keys[index+1:]...)
+}
+
+// moveToFront moves the key at position index, with hash h, to position 0,
+// shifting the positions before it.
+func (idx *dictIndex) moveToFront(h uint64, index int) {
+	for _, positions := range idx.buckets {
+		for j, i := range positions {
+			if i < index {
+				positions[j] = i + 1
+			}
+		}
+	}
+
+	bucket := idx.buckets[h]
+	for j, i := range bucket {
+		if i == index {
+			copy(bucket[1:j+1], bucket[:j])
+			bucket[0] = 0
+			break
+		}
+	}
+
+	key := idx.keys[index]
+	copy(idx.keys[1:index+1], idx.keys[:index])
+	idx.keys[0] = key
+}
@@ -0,0 +1,226 @@
+package ezarr
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type point struct {
+	X, Y int
+}
+
+type caseless string
+
+func (c caseless) Hash() uint64 {
+	return uint64(len(c))
+}
+
+// Test | Hash verifies that equal values hash alike across kinds
+func TestHash(t *testing.T) {
+	pairs := [][2]interface{}{
+		{"abc", "abc"},
+		{42, 42},
+		{int64(42), int64(42)},
+		{3.5, 3.5},
+		{0.0, -0.0 * 1},
+		{[]interface{}{1, "a"}, []interface{}{1, "a"}},
+		{[2]int{1, 2}, [2]int{1, 2}},
+		{map[string]int{"a": 1, "b": 2, "c": 3}, map[string]int{"c": 3, "b": 2, "a": 1}},
+		{point{1, 2}, point{1, 2}},
+		{&point{1, 2}, &point{1, 2}},
+		{New(1, New(2, 3)), New(1, New(2, 3))},
+		{caseless("abc"), caseless("abc")},
+	}
+
+	for _, pair := range pairs {
+		if !Equal(pair[0], pair[1]) {
+			t.Errorf("Expected %v and %v to be equal", pair[0], pair[1])
+		}
+		if Hash(pair[0]) != Hash(pair[1]) {
+			t.Errorf("Expected equal hashes for %v and %v", pair[0], pair[1])
+		}
+	}
+
+	if Hash([]interface{}{1, 2}) == Hash([]interface{}{2, 1}) {
+		t.Error("Expected different hashes for differently ordered slices")
+	}
+
+	inner := New(1)
+	shared := New(inner, inner)
+	if !Equal(shared, New(New(1), New(1))) || Hash(shared) != Hash(New(New(1), New(1))) {
+		t.Error("Expected a sub-object that appears twice to hash like two equal copies")
+	}
+	d := &Dict{}
+	d.Set(New(New(1), New(1)), "x")
+	if val, err := d.Get(shared); err != nil || val != "x" {
+		t.Errorf("Expected x for a key with a shared sub-object, got %v, error: %v", val, err)
+	}
+
+	dag := New(1)
+	for i := 0; i < 64; i++ {
+		dag = New(dag, dag)
+	}
+	d.Set(dag, "dag")
+	if val, err := d.Get(dag); err != nil || val != "dag" {
+		t.Errorf("Expected dag for a key sharing sub-objects 64 levels deep, got %v, error: %v", val, err)
+	}
+}
+
+// Test | Equal verifies DeepEqual semantics and that a Dict's lookup index is ignored
+func TestEqual(t *testing.T) {
+	if Equal(1, int64(1)) {
+		t.Error("Expected values of different types to be unequal")
+	}
+	if Equal([]int(nil), []int{}) {
+		t.Error("Expected nil and empty slices to be unequal")
+	}
+	if !Equal(nil, nil) || Equal(nil, 0) {
+		t.Error("Expected nil to only equal nil")
+	}
+
+	d1, _ := NewDict("a", 1, "b", 2)
+	d2, _ := NewDict("a", 1, "b", 2)
+	d1.Get("a")
+	if !Equal(d1, d2) {
+		t.Error("Expected dicts with the same items to be equal regardless of lookups")
+	}
+	if Hash(d1) != Hash(d2) {
+		t.Error("Expected dicts with the same items to hash alike")
+	}
+
+	if !Equal(New(), &List{Elements: []interface{}{}}) || Hash(New()) != Hash(&List{Elements: []interface{}{}}) {
+		t.Error("Expected lists with nil and empty Elements to be equal and hash alike")
+	}
+
+	type holder struct {
+		list *List
+		dict Dict
+		item interface{}
+	}
+	pair, _ := NewTupleType("Pair", "x", "y")
+	p, _ := pair.New(1, 2)
+	looked, _ := NewDict("a", 1)
+	looked.Get("a")
+	fresh, _ := NewDict("a", 1)
+	h1 := holder{New(1), *looked, p}
+	h2 := holder{New(1), *fresh, NewTuple(1, 2)}
+	if !Equal(h1, h2) || Hash(h1) != Hash(h2) {
+		t.Error("Expected containers in unexported fields to compare by their elements")
+	}
+
+	cyclic1 := New(1)
+	cyclic1.Append(cyclic1)
+	cyclic2 := New(1)
+	cyclic2.Append(cyclic2)
+	if !Equal(cyclic1, cyclic2) {
+		t.Error("Expected self-referencing lists with the same shape to be equal")
+	}
+	if Hash(cyclic1) != Hash(cyclic2) {
+		t.Error("Expected self-referencing lists with the same shape to hash alike")
+	}
+}
+
+// Test | Dict verifies hash-indexed lookups for non-comparable keys
+func TestDictUnhashableKeys(t *testing.T) {
+	dict := &Dict{}
+	dict.Set([]interface{}{1, 2}, "slice")
+	dict.Set(map[string]int{"a": 1}, "map")
+	dict.Set(New(1, 2), "list")
+	dict.Set(point{1, 2}, "struct")
+
+	cases := map[string]interface{}{
+		"slice":  []interface{}{1, 2},
+		"map":    map[string]int{"a": 1},
+		"list":   New(1, 2),
+		"struct": point{1, 2},
+	}
+	for expected, key := range cases {
+		val, err := dict.Get(key)
+		if err != nil || val != expected {
+			t.Errorf("Expected %v for key %v, got %v, error: %v", expected, key, val, err)
+		}
+	}
+
+	dict.Set(New(1, 2), "list again")
+	if dict.Len() != 4 {
+		t.Errorf("Expected length 4 after overwriting an equal key, got %d", dict.Len())
+	}
+}
+
+// Test | Dict verifies the lookup index follows deletions and the direct slice changes documented on Dict
+func TestDictIndexConsistency(t *testing.T) {
+	dict, _ := NewDict("a", 1, "b", 2, "c", 3)
+
+	dict.Delete("a")
+	if val, err := dict.Get("c"); err != nil || val != 3 {
+		t.Errorf("Expected 3 for key 'c' after delete, got %v, error: %v", val, err)
+	}
+
+	dict.Keys = append(dict.Keys, "d")
+	dict.Values = append(dict.Values, 4)
+	if val, err := dict.Get("d"); err != nil || val != 4 {
+		t.Errorf("Expected 4 for key 'd' appended directly, got %v, error: %v", val, err)
+	}
+
+	dict.Keys = []interface{}{"x"}
+	dict.Values = []interface{}{9}
+	if dict.Contains("b") || !dict.Contains("x") {
+		t.Errorf("Expected lookups to follow replaced Keys, got %v", dict)
+	}
+
+	keys := append([]interface{}(nil), dict.Keys...)
+	keys[0] = "z"
+	dict.Keys = keys
+	if val, err := dict.Get("z"); err != nil || val != 9 || dict.Contains("x") {
+		t.Errorf("Expected lookups to follow a modified copy of Keys, got %v, error: %v", val, err)
+	}
+
+	dups := FromKeys([]interface{}{"k", "k"}, 0)
+	dups.Values[1] = 1
+	if val, _ := dups.Get("k"); val != 0 {
+		t.Errorf("Expected the first of duplicate keys to win, got %v", val)
+	}
+
+	dict, _ = NewDict("a", 1, "b", 2)
+	dict.Keys[0], dict.Keys[1] = dict.Keys[1], dict.Keys[0]
+	dict.Values[0], dict.Values[1] = dict.Values[1], dict.Values[0]
+	if val, err := dict.Get("a"); err != nil || val != 1 {
+		t.Errorf("Expected 1 for key 'a' after reordering in place, got %v, error: %v", val, err)
+	}
+	dict.Set("a", 100)
+	if dict.Len() != 2 || dict.Keys[1] != "a" || dict.Values[1] != 100 {
+		t.Errorf("Expected Set to replace 'a' after reordering in place, got %v", dict)
+	}
+	dict.Set("c", 3)
+	if val, err := dict.Get("b"); err != nil || val != 2 || dict.Len() != 3 {
+		t.Errorf("Expected lookups to work after the index is rebuilt, got %v, error: %v", val, err)
+	}
+}
+
+// Test | Dict verifies lookups leave a Dict unchanged, so equal Dicts stay reflect.DeepEqual
+func TestDictLookupDoesNotWrite(t *testing.T) {
+	d1, _ := NewDict("a", 1, "b", 2)
+	d2 := &Dict{}
+	d2.Set("a", 1).Set("b", 2)
+	d1.Get("a")
+	d1.Contains("missing")
+	if !reflect.DeepEqual(d1, d2) {
+		t.Errorf("Expected equal Dicts to be DeepEqual after lookups, got %#v and %#v", d1, d2)
+	}
+
+	d1.Keys = append(d1.Keys, "c")
+	d1.Values = append(d1.Values, 3)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				d1.Get("c")
+				d1.Contains("missing")
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,318 @@
+package ezarr
+
+// The heap functions keep a *List as a binary min-heap, like Python's heapq
+// module: l.Elements[0] is always the smallest element. Elements are ordered
+// with Compare, as in List.Sort. Every function takes an optional key
+// function; when key is not nil, elements are ordered by key(element).
+// A comparison error is returned as is, and may leave the heap partially
+// reordered, as it would in Python.
+
+func HeapPush(l *List, element interface{}, key func(interface{}) interface{}) error {
+	l.Elements = append(l.Elements, element)
+	return siftDown(l.Elements, 0, len(l.Elements)-1, heapLess(key))
+}
+
+func HeapPop(l *List, key func(interface{}) interface{}) (interface{}, error) {
+	if len(l.Elements) == 0 {
+		return nil, popFromEmptyHeap()
+	}
+
+	last := len(l.Elements) - 1
+	element := l.Elements[last]
+	l.Elements[last] = nil
+	l.Elements = l.Elements[:last]
+	if last == 0 {
+		return element, nil
+	}
+
+	element, l.Elements[0] = l.Elements[0], element
+	return element, siftUp(l.Elements, 0, heapLess(key))
+}
+
+// HeapPushPop pushes element and then pops the smallest element, which is
+// faster than calling HeapPush followed by HeapPop.
+func HeapPushPop(l *List, element interface{}, key func(interface{}) interface{}) (interface{}, error) {
+	if len(l.Elements) == 0 {
+		return element, nil
+	}
+
+	less := heapLess(key)
+	smaller, err := less(l.Elements[0], element)
+	if err != nil || !smaller {
+		return element, err
+	}
+
+	element, l.Elements[0] = l.Elements[0], element
+	return element, siftUp(l.Elements, 0, less)
+}
+
+// HeapReplace pops the smallest element and then pushes element. The
+// returned element may be larger than the one pushed.
+func HeapReplace(l *List, element interface{}, key func(interface{}) interface{}) (interface{}, error) {
+	if len(l.Elements) == 0 {
+		return nil, popFromEmptyHeap()
+	}
+
+	element, l.Elements[0] = l.Elements[0], element
+	return element, siftUp(l.Elements, 0, heapLess(key))
+}
+
+func Heapify(l *List, key func(interface{}) interface{}) error {
+	less := heapLess(key)
+	for i := len(l.Elements)/2 - 1; i >= 0; i-- {
+		if err := siftUp(l.Elements, i, less); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NLargest returns the n largest elements of l, from the largest down.
+// Equal elements keep their order in l.
+func NLargest(n int, l *List, key func(interface{}) interface{}) (*List, error) {
+	return nBest(n, l, key, true)
+}
+
+// NSmallest returns the n smallest elements of l, from the smallest up.
+// Equal elements keep their order in l.
+func NSmallest(n int, l *List, key func(interface{}) interface{}) (*List, error) {
+	return nBest(n, l, key, false)
+}
+
+// Merge merges lists that are each sorted into a single sorted List. With
+// reverse set, the lists must be sorted from the largest down. Equal
+// elements are taken from the earlier list first.
+func Merge(key func(interface{}) interface{}, reverse bool, lists ...*List) (*List, error) {
+	type cursor struct {
+		list, pos int
+		key       interface{}
+	}
+
+	keyOf := func(e interface{}) interface{} {
+		if key == nil {
+			return e
+		}
+		return key(e)
+	}
+
+	var h []interface{}
+	less := func(a, b interface{}) (bool, error) {
+		ca, cb := a.(*cursor), b.(*cursor)
+		c, err := Compare(ca.key, cb.key)
+		if err != nil {
+			return false, err
+		}
+		if reverse {
+			c = -c
+		}
+		return c < 0 || c == 0 && ca.list < cb.list, nil
+	}
+
+	total := 0
+	for i, l := range lists {
+		total += len(l.Elements)
+		if len(l.Elements) > 0 {
+			h = append(h, &cursor{list: i, key: keyOf(l.Elements[0])})
+		}
+	}
+	for i := len(h)/2 - 1; i >= 0; i-- {
+		if err := siftUp(h, i, less); err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]interface{}, 0, total)
+	for len(h) > 0 {
+		c := h[0].(*cursor)
+		elements := lists[c.list].Elements
+		result = append(result, elements[c.pos])
+
+		c.pos++
+		if c.pos < len(elements) {
+			c.key = keyOf(elements[c.pos])
+		} else {
+			h[0] = h[len(h)-1]
+			h = h[:len(h)-1]
+		}
+		if len(h) > 0 {
+			if err := siftUp(h, 0, less); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &List{Elements: result}, nil
+}
+
+// ListHeap adapts a *List to container/heap.Interface. Less cannot return
+// an error, so the first comparison error is kept in Err and the failing
+// comparison reports false.
+type ListHeap struct {
+	List *List
+	Key  func(interface{}) interface{}
+	Err  error
+}
+
+func NewListHeap(l *List, key func(interface{}) interface{}) *ListHeap {
+	return &ListHeap{List: l, Key: key}
+}
+
+func (h *ListHeap) Len() int {
+	return len(h.List.Elements)
+}
+
+func (h *ListHeap) Less(i, j int) bool {
+	less, err := heapLess(h.Key)(h.List.Elements[i], h.List.Elements[j])
+	if err != nil && h.Err == nil {
+		h.Err = err
+	}
+	return less
+}
+
+func (h *ListHeap) Swap(i, j int) {
+	h.List.Elements[i], h.List.Elements[j] = h.List.Elements[j], h.List.Elements[i]
+}
+
+func (h *ListHeap) Push(x interface{}) {
+	h.List.Elements = append(h.List.Elements, x)
+}
+
+func (h *ListHeap) Pop() interface{} {
+	last := len(h.List.Elements) - 1
+	element := h.List.Elements[last]
+	h.List.Elements[last] = nil
+	h.List.Elements = h.List.Elements[:last]
+	return element
+}
+
+func heapLess(key func(interface{}) interface{}) func(a, b interface{}) (bool, error) {
+	return func(a, b interface{}) (bool, error) {
+		if key != nil {
+			a, b = key(a), key(b)
+		}
+		c, err := Compare(a, b)
+		return c < 0, err
+	}
+}
+
+// siftDown moves the element at pos towards the root until its parent is
+// not larger. The names follow CPython's heapq.
+func siftDown(heap []interface{}, start, pos int, less func(a, b interface{}) (bool, error)) error {
+	element := heap[pos]
+	for pos > start {
+		parent := (pos - 1) / 2
+		smaller, err := less(element, heap[parent])
+		if err != nil {
+			heap[pos] = element
+			return err
+		}
+		if !smaller {
+			break
+		}
+		heap[pos] = heap[parent]
+		pos = parent
+	}
+	heap[pos] = element
+	return nil
+}
+
+// siftUp moves the smaller child up until a leaf is reached and then sifts
+// the element at pos back down, which takes fewer comparisons on average
+// than stopping early.
+func siftUp(heap []interface{}, pos int, less func(a, b interface{}) (bool, error)) error {
+	end := len(heap)
+	start := pos
+	element := heap[pos]
+	child := 2*pos + 1
+	for child < end {
+		if right := child + 1; right < end {
+			smaller, err := less(heap[child], heap[right])
+			if err != nil {
+				heap[pos] = element
+				return err
+			}
+			if !smaller {
+				child = right
+			}
+		}
+		heap[pos] = heap[child]
+		pos = child
+		child = 2*pos + 1
+	}
+	heap[pos] = element
+	return siftDown(heap, start, pos, less)
+}
+
+// nBest selects the n best elements with a heap of size n whose root is
+// the worst element kept so far. Ties are broken by position in l, which
+// keeps the result stable like sorted(...)[:n].
+func nBest(n int, l *List, key func(interface{}) interface{}, largest bool) (*List, error) {
+	if n <= 0 {
+		return &List{Elements: []interface{}{}}, nil
+	}
+	if n >= len(l.Elements) {
+		result := l.Copy()
+		if err := result.SortBy(key, largest); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	type entry struct {
+		key      interface{}
+		position int
+	}
+	worse := func(a, b interface{}) (bool, error) {
+		ea, eb := a.(*entry), b.(*entry)
+		c, err := Compare(ea.key, eb.key)
+		if err != nil {
+			return false, err
+		}
+		if largest {
+			c = -c
+		}
+		return c > 0 || c == 0 && ea.position > eb.position, nil
+	}
+	newEntry := func(i int) *entry {
+		e := &entry{key: l.Elements[i], position: i}
+		if key != nil {
+			e.key = key(e.key)
+		}
+		return e
+	}
+
+	h := make([]interface{}, n)
+	for i := range h {
+		h[i] = newEntry(i)
+	}
+	for i := n/2 - 1; i >= 0; i-- {
+		if err := siftUp(h, i, worse); err != nil {
+			return nil, err
+		}
+	}
+	for i := n; i < len(l.Elements); i++ {
+		e := newEntry(i)
+		better, err := worse(h[0], e)
+		if err != nil {
+			return nil, err
+		}
+		if better {
+			h[0] = e
+			if err := siftUp(h, 0, worse); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result := make([]interface{}, n)
+	for i := n - 1; i >= 0; i-- {
+		result[i] = l.Elements[h[0].(*entry).position]
+		h[0] = h[len(h)-1]
+		h = h[:len(h)-1]
+		if len(h) > 0 {
+			if err := siftUp(h, 0, worse); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &List{Elements: result}, nil
+}
@@ -0,0 +1,165 @@
+package ezarr
+
+import (
+	"container/heap"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func isHeap(t *testing.T, l *List) {
+	t.Helper()
+	for i := 1; i < len(l.Elements); i++ {
+		if c, _ := Compare(l.Elements[(i-1)/2], l.Elements[i]); c > 0 {
+			t.Fatalf("Heap invariant broken at index %d: %v", i, l)
+		}
+	}
+}
+
+// Test | HeapPush and HeapPop verify that elements come out in sorted order
+func TestHeapPushPop(t *testing.T) {
+	h := New()
+	values := rand.New(rand.NewSource(1)).Perm(50)
+	for _, v := range values {
+		if err := HeapPush(h, v, nil); err != nil {
+			t.Fatalf("HeapPush returned error: %v", err)
+		}
+		isHeap(t, h)
+	}
+
+	for i := 0; i < 50; i++ {
+		v, err := HeapPop(h, nil)
+		if err != nil || v != i {
+			t.Fatalf("Expected to pop %d, got %v, error: %v", i, v, err)
+		}
+		isHeap(t, h)
+	}
+
+	if _, err := HeapPop(h, nil); !errors.Is(err, ErrIndex) || !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected empty IndexError, got %v", err)
+	}
+	if _, err := HeapReplace(h, 1, nil); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected empty IndexError from HeapReplace, got %v", err)
+	}
+}
+
+// Test | Heapify, HeapPushPop and HeapReplace verify results against Python
+func TestHeapify(t *testing.T) {
+	h := New(5, 3, 8, 1, 9, 2)
+	if err := Heapify(h, nil); err != nil {
+		t.Fatalf("Heapify returned error: %v", err)
+	}
+	if !Equal(h, New(1, 3, 2, 5, 9, 8)) {
+		t.Errorf("Expected [1, 3, 2, 5, 9, 8], got %v", h)
+	}
+
+	if v, err := HeapPushPop(h, 0, nil); err != nil || v != 0 || h.Elements[0] != 1 {
+		t.Errorf("Expected HeapPushPop to return the smaller pushed element, got %v, error: %v", v, err)
+	}
+	if v, err := HeapPushPop(h, 4, nil); err != nil || v != 1 || !Equal(h, New(2, 3, 4, 5, 9, 8)) {
+		t.Errorf("Expected 1 and [2, 3, 4, 5, 9, 8], got %v and %v, error: %v", v, h, err)
+	}
+	if v, err := HeapReplace(h, 10, nil); err != nil || v != 2 || !Equal(h, New(3, 5, 4, 10, 9, 8)) {
+		t.Errorf("Expected 2 and [3, 5, 4, 10, 9, 8], got %v and %v, error: %v", v, h, err)
+	}
+
+	if err := Heapify(New(1, "a", 2), nil); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError for incomparable elements, got %v", err)
+	}
+}
+
+// Test | Heap functions verify ordering by a key function
+func TestHeapKey(t *testing.T) {
+	tasks := New()
+	byPriority := func(e interface{}) interface{} {
+		p, _ := e.(Tuple).Get(1)
+		return p
+	}
+	HeapPush(tasks, NewTuple("write", 2), byPriority)
+	HeapPush(tasks, NewTuple("test", 3), byPriority)
+	HeapPush(tasks, NewTuple("plan", 1), byPriority)
+
+	task, err := HeapPop(tasks, byPriority)
+	if err != nil || Repr(task) != "('plan', 1)" {
+		t.Errorf("Expected ('plan', 1), got %v, error: %v", task, err)
+	}
+}
+
+// Test | NLargest and NSmallest verify stable selection against Python results
+func TestNLargestSmallest(t *testing.T) {
+	list := New(3, 1, 4, 1, 5, 9, 2, 6, 5, 3)
+
+	cases := []struct {
+		name     string
+		fn       func(int, *List, func(interface{}) interface{}) (*List, error)
+		n        int
+		expected *List
+	}{
+		{"largest 3", NLargest, 3, New(9, 6, 5)},
+		{"smallest 3", NSmallest, 3, New(1, 1, 2)},
+		{"largest all", NLargest, 20, New(9, 6, 5, 5, 4, 3, 3, 2, 1, 1)},
+		{"smallest 0", NSmallest, 0, New()},
+	}
+	for _, c := range cases {
+		got, err := c.fn(c.n, list, nil)
+		if err != nil || !Equal(got, c.expected) {
+			t.Errorf("%s: expected %v, got %v, error: %v", c.name, c.expected, got, err)
+		}
+	}
+
+	words := New("bb", "a", "cc", "d", "ee")
+	byLen := func(e interface{}) interface{} { return len(e.(string)) }
+	if got, _ := NLargest(2, words, byLen); !Equal(got, New("bb", "cc")) {
+		t.Errorf("Expected ties to keep their order, got %v", got)
+	}
+	if got, _ := NSmallest(2, words, byLen); !Equal(got, New("a", "d")) {
+		t.Errorf("Expected ties to keep their order, got %v", got)
+	}
+	if !Equal(list, New(3, 1, 4, 1, 5, 9, 2, 6, 5, 3)) {
+		t.Error("Expected the input list to be unchanged")
+	}
+}
+
+// Test | Merge verifies merging sorted lists, with key and reverse
+func TestHeapMerge(t *testing.T) {
+	got, err := Merge(nil, false, New(1, 4, 7), New(), New(2, 5, 8), New(3, 6))
+	if err != nil || !Equal(got, New(1, 2, 3, 4, 5, 6, 7, 8)) {
+		t.Errorf("Expected [1..8], got %v, error: %v", got, err)
+	}
+
+	got, _ = Merge(nil, true, New(9, 5, 1), New(8, 5, 2))
+	if !Equal(got, New(9, 8, 5, 5, 2, 1)) {
+		t.Errorf("Expected [9, 8, 5, 5, 2, 1], got %v", got)
+	}
+
+	first := func(e interface{}) interface{} { v, _ := e.(Tuple).Get(0); return v }
+	got, _ = Merge(first, false, New(NewTuple(1, "a"), NewTuple(2, "a")), New(NewTuple(1, "b")))
+	if Repr(got) != "[(1, 'a'), (1, 'b'), (2, 'a')]" {
+		t.Errorf("Expected equal keys to come from the earlier list first, got %s", Repr(got))
+	}
+
+	if got, _ := Merge(nil, false); got.Len() != 0 {
+		t.Errorf("Expected an empty list without input, got %v", got)
+	}
+}
+
+// Test | ListHeap verifies the container/heap adapter
+func TestListHeap(t *testing.T) {
+	h := NewListHeap(New(5, 2, 8), nil)
+	heap.Init(h)
+	heap.Push(h, 1)
+
+	var popped []interface{}
+	for h.Len() > 0 {
+		popped = append(popped, heap.Pop(h))
+	}
+	if !Equal(popped, []interface{}{1, 2, 5, 8}) || h.Err != nil {
+		t.Errorf("Expected [1, 2, 5, 8], got %v, error: %v", popped, h.Err)
+	}
+
+	bad := NewListHeap(New(1, "a"), nil)
+	heap.Init(bad)
+	if !errors.Is(bad.Err, ErrType) {
+		t.Errorf("Expected the comparison error to be kept, got %v", bad.Err)
+	}
+}
@@ -0,0 +1,453 @@
+package ezarr
+
+import "iter"
+
+// Iterators for use with range-over-func. Sequences yield (index, element)
+// pairs from All and Backward and plain elements from Values. Mappings yield
+// (key, value) pairs from All; Dict already has Keys and Values fields, so
+// mappings name their key and value iterators IterKeys and IterValues.
+//
+// Like Python, iterating a list sees changes made during the loop, while
+// iterating a mapping, a set, a deque or a sorted container panics with a
+// *RuntimeError if the container changes size during the loop.
+
+func (l *List) All() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		for i := 0; i < len(l.Elements); i++ {
+			if !yield(i, l.Elements[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (l *List) Backward() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		for i := len(l.Elements) - 1; i >= 0; i-- {
+			if i >= len(l.Elements) {
+				return
+			}
+			if !yield(i, l.Elements[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (l *List) Values() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for i := 0; i < len(l.Elements); i++ {
+			if !yield(l.Elements[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (l *TypedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < len(l.Elements); i++ {
+			if !yield(i, l.Elements[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (l *TypedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(l.Elements) - 1; i >= 0; i-- {
+			if i >= len(l.Elements) {
+				return
+			}
+			if !yield(i, l.Elements[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (l *TypedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < len(l.Elements); i++ {
+			if !yield(l.Elements[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (t Tuple) All() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		for i, e := range t.elements {
+			if !yield(i, e) {
+				return
+			}
+		}
+	}
+}
+
+func (t Tuple) Backward() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		for i := len(t.elements) - 1; i >= 0; i-- {
+			if !yield(i, t.elements[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (t Tuple) Values() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for _, e := range t.elements {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+func (d *Deque) All() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		n := d.length
+		for i := 0; i < n; i++ {
+			if !yield(i, d.buf[d.position(i)]) {
+				return
+			}
+			if d.length != n {
+				panic(changedSize("deque"))
+			}
+		}
+	}
+}
+
+func (d *Deque) Backward() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		n := d.length
+		for i := n - 1; i >= 0; i-- {
+			if !yield(i, d.buf[d.position(i)]) {
+				return
+			}
+			if d.length != n {
+				panic(changedSize("deque"))
+			}
+		}
+	}
+}
+
+func (d *Deque) Values() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for _, e := range d.All() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+func (s *SortedList) All() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		n := s.length
+		i := 0
+		for ci := 0; ci < len(s.chunks); ci++ {
+			chunk := s.chunks[ci]
+			for _, e := range chunk {
+				if !yield(i, e) {
+					return
+				}
+				if s.length != n {
+					panic(changedSize("SortedList"))
+				}
+				i++
+			}
+		}
+	}
+}
+
+func (s *SortedList) Backward() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		n := s.length
+		i := n - 1
+		for ci := len(s.chunks) - 1; ci >= 0; ci-- {
+			chunk := s.chunks[ci]
+			for pos := len(chunk) - 1; pos >= 0; pos-- {
+				if !yield(i, chunk[pos]) {
+					return
+				}
+				if s.length != n {
+					panic(changedSize("SortedList"))
+				}
+				i--
+			}
+		}
+	}
+}
+
+func (s *SortedList) Values() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for _, e := range s.All() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// All yields nothing until it is ranged over, so iterating a huge Range
+// costs no memory.
+func (r Range) All() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		v := r.start
+		for i := 0; i < r.length; i++ {
+			if !yield(i, v) {
+				return
+			}
+			v += r.step
+		}
+	}
+}
+
+func (r Range) Backward() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		v := r.start + (r.length-1)*r.step
+		for i := r.length - 1; i >= 0; i-- {
+			if !yield(i, v) {
+				return
+			}
+			v -= r.step
+		}
+	}
+}
+
+func (r Range) Values() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, v := range r.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (d *Dict) All() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		n := len(d.Keys)
+		for i := 0; i < n; i++ {
+			if !yield(d.Keys[i], d.Values[i]) {
+				return
+			}
+			if len(d.Keys) != n {
+				panic(changedSize("dictionary"))
+			}
+		}
+	}
+}
+
+func (d *Dict) Backward() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		n := len(d.Keys)
+		for i := n - 1; i >= 0; i-- {
+			if !yield(d.Keys[i], d.Values[i]) {
+				return
+			}
+			if len(d.Keys) != n {
+				panic(changedSize("dictionary"))
+			}
+		}
+	}
+}
+
+func (d *Dict) IterKeys() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for k := range d.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func (d *Dict) IterValues() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for _, v := range d.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Items yields each item as a (key, value) Tuple.
+func (d *Dict) Items() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for k, v := range d.All() {
+			if !yield(NewTuple(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+func (d *TypedDict[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		n := d.Len()
+		for i := 0; i < len(d.keys); i++ {
+			if !d.live[i] {
+				continue
+			}
+			if !yield(d.keys[i], d.values[i]) {
+				return
+			}
+			if d.Len() != n {
+				panic(changedSize("dictionary"))
+			}
+		}
+	}
+}
+
+func (d *TypedDict[K, V]) IterKeys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range d.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func (d *TypedDict[K, V]) IterValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range d.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (d *SortedDict) All() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		for _, e := range d.entries.All() {
+			entry := e.(*sortedEntry)
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+func (d *SortedDict) Backward() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		for _, e := range d.entries.Backward() {
+			entry := e.(*sortedEntry)
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+func (d *SortedDict) IterKeys() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for k := range d.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func (d *SortedDict) IterValues() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for _, v := range d.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Items yields each item as a (key, value) Tuple.
+func (d *SortedDict) Items() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for k, v := range d.All() {
+			if !yield(NewTuple(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+func (c *Counter) All() iter.Seq2[interface{}, int] {
+	return func(yield func(interface{}, int) bool) {
+		for k, v := range c.counts.All() {
+			if !yield(k, v.(int)) {
+				return
+			}
+		}
+	}
+}
+
+func (c *Counter) IterKeys() iter.Seq[interface{}] {
+	return c.counts.IterKeys()
+}
+
+// All iterates over a snapshot of the ChainMap taken when iteration
+// starts, as Python does.
+func (c *ChainMap) All() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		for k, v := range c.ToDict().All() {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (c *ChainMap) IterKeys() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for k := range c.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// All yields the elements of the Set.
+func (s *Set) All() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		n := len(s.items.Keys)
+		for i := 0; i < n; i++ {
+			if !yield(s.items.Keys[i]) {
+				return
+			}
+			if len(s.items.Keys) != n {
+				panic(changedSize("set"))
+			}
+		}
+	}
+}
+
+func (f *FrozenSet) All() iter.Seq[interface{}] {
+	return f.set.All()
+}
+
+// Collect returns a List of the values yielded by seq.
+func Collect[T any](seq iter.Seq[T]) *List {
+	elements := []interface{}{}
+	for v := range seq {
+		elements = append(elements, v)
+	}
+	return &List{Elements: elements}
+}
+
+// CollectDict returns a Dict of the pairs yielded by seq. A key yielded more
+// than once keeps its first position and its last value.
+func CollectDict[K, V any](seq iter.Seq2[K, V]) *Dict {
+	d := &Dict{Keys: []interface{}{}, Values: []interface{}{}}
+	for k, v := range seq {
+		d.Set(k, v)
+	}
+	return d
+}
@@ -0,0 +1,190 @@
+package ezarr
+
+import (
+	"errors"
+	"testing"
+)
+
+func expectChangedSize(t *testing.T, name string, loop func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrRuntime) {
+			t.Errorf("%s: expected a RuntimeError panic, got %v", name, err)
+		}
+	}()
+	loop()
+}
+
+// Test | Sequence iterators verify All, Backward and Values
+func TestSequenceIterators(t *testing.T) {
+	l := New("a", "b", "c")
+
+	var indexes []int
+	for i, e := range l.All() {
+		indexes = append(indexes, i)
+		if e != l.Elements[i] {
+			t.Errorf("Expected %v at %d, got %v", l.Elements[i], i, e)
+		}
+	}
+	if !Equal(indexes, []int{0, 1, 2}) {
+		t.Errorf("Expected indexes [0, 1, 2], got %v", indexes)
+	}
+	if got := Collect(l.Values()); !Equal(got, l) {
+		t.Errorf("Expected %v, got %v", l, got)
+	}
+
+	var backward []interface{}
+	for i, e := range l.Backward() {
+		backward = append(backward, i, e)
+	}
+	if !Equal(backward, []interface{}{2, "c", 1, "b", 0, "a"}) {
+		t.Errorf("Unexpected backward iteration: %v", backward)
+	}
+
+	d := DequeFromList(New(1, 2, 3)).Rotate(1)
+	s, _ := NewSortedList(nil, 3, 1, 2)
+	r, _ := NewRange(3, 0, -1)
+	cases := []struct {
+		name     string
+		got      *List
+		expected *List
+	}{
+		{"tuple", Collect(NewTuple(1, 2).Values()), New(1, 2)},
+		{"deque", Collect(d.Values()), New(3, 1, 2)},
+		{"sorted list", Collect(s.Values()), New(1, 2, 3)},
+		{"range", Collect(r.Values()), New(3, 2, 1)},
+		{"typed list", Collect(NewTypedList("x", "y").Values()), New("x", "y")},
+	}
+	for _, c := range cases {
+		if !Equal(c.got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, c.got)
+		}
+	}
+
+	var last []interface{}
+	for i, v := range r.Backward() {
+		last = append(last, i, v)
+		break
+	}
+	if !Equal(last, []interface{}{2, 1}) {
+		t.Errorf("Expected range Backward to start at (2, 1), got %v", last)
+	}
+}
+
+// Test | List iteration verifies that changes during the loop are seen, as in Python
+func TestListIterationChanges(t *testing.T) {
+	l := New(1, 2, 3)
+	var seen []interface{}
+	for _, e := range l.All() {
+		seen = append(seen, e)
+		if e == 1 {
+			l.Append(4)
+		}
+	}
+	if !Equal(seen, []interface{}{1, 2, 3, 4}) {
+		t.Errorf("Expected the appended element to be visited, got %v", seen)
+	}
+
+	for _, e := range l.Backward() {
+		if e == 4 {
+			l.Clear()
+		}
+	}
+}
+
+// Test | Mapping iterators verify All, IterKeys, IterValues and Items
+func TestMappingIterators(t *testing.T) {
+	d, _ := NewDict("a", 1, "b", 2)
+
+	if got := CollectDict(d.All()); !d.Equal(got, true) {
+		t.Errorf("Expected %v, got %v", d, got)
+	}
+	if !Equal(Collect(d.IterKeys()), New("a", "b")) || !Equal(Collect(d.IterValues()), New(1, 2)) {
+		t.Error("Unexpected keys or values")
+	}
+	if Repr(Collect(d.Items())) != "[('a', 1), ('b', 2)]" {
+		t.Errorf("Unexpected items: %s", Repr(Collect(d.Items())))
+	}
+	if Repr(CollectDict(d.Backward())) != "{'b': 2, 'a': 1}" {
+		t.Errorf("Unexpected backward order: %s", Repr(CollectDict(d.Backward())))
+	}
+
+	sd, _ := NewSortedDict(2, "b", 1, "a")
+	if Repr(CollectDict(sd.All())) != "{1: 'a', 2: 'b'}" || !Equal(Collect(sd.IterKeys()), New(1, 2)) {
+		t.Errorf("Expected sorted iteration, got %s", Repr(CollectDict(sd.All())))
+	}
+
+	c := CounterFromList(New("x", "y", "x"))
+	counts := map[interface{}]int{}
+	for k, n := range c.All() {
+		counts[k] = n
+	}
+	if counts["x"] != 2 || counts["y"] != 1 {
+		t.Errorf("Unexpected counts: %v", counts)
+	}
+
+	typed := NewTypedDict[string, int]().Set("a", 1).Set("b", 2).Set("c", 3)
+	typed.Delete("b")
+	if got := Collect(typed.IterKeys()); !Equal(got, New("a", "c")) {
+		t.Errorf("Expected deleted keys to be skipped, got %v", got)
+	}
+
+	top, _ := NewDict("a", 10)
+	chain := NewChainMap(top, d)
+	if Repr(CollectDict(chain.All())) != "{'a': 10, 'b': 2}" {
+		t.Errorf("Unexpected ChainMap items: %s", Repr(CollectDict(chain.All())))
+	}
+
+	set := NewSet(1, 2, 3)
+	if got := Collect(set.All()); got.Len() != 3 {
+		t.Errorf("Expected 3 set elements, got %v", got)
+	}
+}
+
+// Test | Iterators verify that a size change during the loop panics like Python
+func TestIterationChangedSize(t *testing.T) {
+	d, _ := NewDict("a", 1, "b", 2)
+	expectChangedSize(t, "dict", func() {
+		for k := range d.IterKeys() {
+			d.Delete(k)
+		}
+	})
+
+	set := NewSet(1, 2)
+	expectChangedSize(t, "set", func() {
+		for e := range set.All() {
+			set.Add(e.(int) + 10)
+		}
+	})
+
+	deque := NewDeque(1, 2)
+	expectChangedSize(t, "deque", func() {
+		for range deque.Values() {
+			deque.Append(3)
+		}
+	})
+
+	sd, _ := NewSortedDict(1, "a", 2, "b")
+	expectChangedSize(t, "sorted dict", func() {
+		for k := range sd.All() {
+			sd.Set(k.(int)+10, "x")
+		}
+	})
+
+	typed := NewTypedDict[string, int]().Set("a", 1).Set("b", 2)
+	expectChangedSize(t, "typed dict", func() {
+		for k := range typed.All() {
+			typed.Delete(k)
+		}
+	})
+
+	d.Set("c", 3)
+	for k := range d.All() {
+		d.Set(k, 0)
+	}
+	if !Equal(d.GetValues(), New(0, 0)) {
+		t.Errorf("Expected values to be replaceable during iteration, got %v", d)
+	}
+}
@@ -0,0 +1,187 @@
+package itertools
+
+import "github.com/NovaDAndrew/ezarr"
+
+// The combinatoric functions read their input completely when ranging
+// starts, as Python does, and then yield Tuples in lexicographic order of
+// the input positions.
+
+// Product yields the cartesian product of its, like nested for loops with
+// the last Iterable varying fastest. With no Iterables it yields one empty
+// Tuple.
+func Product(its ...Iterable) Seq {
+	return func(yield func(interface{}) bool) {
+		pools := make([][]interface{}, len(its))
+		for i, it := range its {
+			pools[i] = collect(it)
+			if len(pools[i]) == 0 {
+				return
+			}
+		}
+
+		indices := make([]int, len(pools))
+		for {
+			if !yield(pick(pools, indices)) {
+				return
+			}
+			i := len(indices) - 1
+			for ; i >= 0; i-- {
+				indices[i]++
+				if indices[i] < len(pools[i]) {
+					break
+				}
+				indices[i] = 0
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// Permutations yields every ordering of r elements of it, or of all of
+// them when r is ezarr.Omit. It returns a ValueError for a negative r.
+func Permutations(it Iterable, r int) (Seq, error) {
+	if r < 0 && r != ezarr.Omit {
+		return nil, &ezarr.ValueError{Value: r, Msg: "r must be non-negative"}
+	}
+	return func(yield func(interface{}) bool) {
+		pool := collect(it)
+		n, k := len(pool), r
+		if k == ezarr.Omit {
+			k = n
+		}
+		if k > n {
+			return
+		}
+
+		indices := make([]int, n)
+		for i := range indices {
+			indices[i] = i
+		}
+		cycles := make([]int, k)
+		for i := range cycles {
+			cycles[i] = n - i
+		}
+		if !yield(tupleOf(pool, indices[:k])) {
+			return
+		}
+
+		for n > 0 {
+			i := k - 1
+			for ; i >= 0; i-- {
+				cycles[i]--
+				if cycles[i] == 0 {
+					first := indices[i]
+					copy(indices[i:], indices[i+1:])
+					indices[n-1] = first
+					cycles[i] = n - i
+					continue
+				}
+				j := n - cycles[i]
+				indices[i], indices[j] = indices[j], indices[i]
+				if !yield(tupleOf(pool, indices[:k])) {
+					return
+				}
+				break
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}, nil
+}
+
+// Combinations yields every choice of r elements of it, keeping their order
+// in it. It returns a ValueError for a negative r.
+func Combinations(it Iterable, r int) (Seq, error) {
+	if r < 0 {
+		return nil, &ezarr.ValueError{Value: r, Msg: "r must be non-negative"}
+	}
+	return func(yield func(interface{}) bool) {
+		pool := collect(it)
+		n := len(pool)
+		if r > n {
+			return
+		}
+
+		indices := make([]int, r)
+		for i := range indices {
+			indices[i] = i
+		}
+		for {
+			if !yield(tupleOf(pool, indices)) {
+				return
+			}
+			i := r - 1
+			for i >= 0 && indices[i] == i+n-r {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+			for j := i + 1; j < r; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+		}
+	}, nil
+}
+
+// CombinationsWithReplacement yields every choice of r elements of it where
+// an element may be chosen more than once. It returns a ValueError for a
+// negative r.
+func CombinationsWithReplacement(it Iterable, r int) (Seq, error) {
+	if r < 0 {
+		return nil, &ezarr.ValueError{Value: r, Msg: "r must be non-negative"}
+	}
+	return func(yield func(interface{}) bool) {
+		pool := collect(it)
+		n := len(pool)
+		if n == 0 && r > 0 {
+			return
+		}
+
+		indices := make([]int, r)
+		for {
+			if !yield(tupleOf(pool, indices)) {
+				return
+			}
+			i := r - 1
+			for i >= 0 && indices[i] == n-1 {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			value := indices[i] + 1
+			for j := i; j < r; j++ {
+				indices[j] = value
+			}
+		}
+	}, nil
+}
+
+func collect(it Iterable) []interface{} {
+	var elements []interface{}
+	for v := range it.Values() {
+		elements = append(elements, v)
+	}
+	return elements
+}
+
+func tupleOf(pool []interface{}, indices []int) ezarr.Tuple {
+	elements := make([]interface{}, len(indices))
+	for i, index := range indices {
+		elements[i] = pool[index]
+	}
+	return ezarr.NewTuple(elements...)
+}
+
+func pick(pools [][]interface{}, indices []int) ezarr.Tuple {
+	elements := make([]interface{}, len(indices))
+	for i, index := range indices {
+		elements[i] = pools[i][index]
+	}
+	return ezarr.NewTuple(elements...)
+}
@@ -0,0 +1,66 @@
+package itertools
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NovaDAndrew/ezarr"
+)
+
+// Test | Combinatoric iterators verify results against Python
+func TestCombinatoric(t *testing.T) {
+	check(t, "Product", Product(ezarr.New(1, 2), ezarr.New("x", "y")).ToList(), "[(1, 'x'), (1, 'y'), (2, 'x'), (2, 'y')]")
+	check(t, "Product none", Product().ToList(), "[()]")
+	check(t, "Product empty", Product(ezarr.New(1), ezarr.New()).ToList(), "[]")
+
+	cases := []struct {
+		name     string
+		fn       func(Iterable, int) (Seq, error)
+		it       *ezarr.List
+		r        int
+		expected string
+	}{
+		{"Permutations", Permutations, ezarr.New("a", "b", "c"), 2, "[('a', 'b'), ('a', 'c'), ('b', 'a'), ('b', 'c'), ('c', 'a'), ('c', 'b')]"},
+		{"Permutations r=0", Permutations, ezarr.New(1, 2), 0, "[()]"},
+		{"Permutations r>n", Permutations, ezarr.New(1, 2), 3, "[]"},
+		{"Combinations", Combinations, ezarr.New(1, 2, 3, 4), 2, "[(1, 2), (1, 3), (1, 4), (2, 3), (2, 4), (3, 4)]"},
+		{"Combinations r>n", Combinations, ezarr.New(1), 2, "[]"},
+		{"CombinationsWithReplacement", CombinationsWithReplacement, ezarr.New("a", "b"), 3, "[('a', 'a', 'a'), ('a', 'a', 'b'), ('a', 'b', 'b'), ('b', 'b', 'b')]"},
+		{"CombinationsWithReplacement empty", CombinationsWithReplacement, ezarr.New(), 1, "[]"},
+	}
+	for _, c := range cases {
+		s, err := c.fn(c.it, c.r)
+		if err != nil {
+			t.Fatalf("%s returned error: %v", c.name, err)
+		}
+		check(t, c.name, s.ToList(), c.expected)
+		if _, err := c.fn(c.it, -1); !errors.Is(err, ezarr.ErrValue) {
+			t.Errorf("%s: expected ValueError for a negative r, got %v", c.name, err)
+		}
+	}
+
+	all, _ := Permutations(ezarr.RangeTo(5).ToList(), ezarr.Omit)
+	if got := all.ToList(); got.Len() != 120 || ezarr.Repr(got.Elements[119]) != "(4, 3, 2, 1, 0)" {
+		t.Errorf("Expected 120 permutations ending with (4, 3, 2, 1, 0), got %d", got.Len())
+	}
+}
+
+// Test | Combinatoric iterators verify that they compose with other iterators
+func TestCombinatoricCompose(t *testing.T) {
+	pairs, _ := Combinations(ezarr.New(1, 2, 3), 2)
+	sums := Accumulate(Chain(pairs, ezarr.New(ezarr.NewTuple(4, 5))), func(total, pair interface{}) interface{} {
+		if first, ok := total.(ezarr.Tuple); ok {
+			total = sumPair(first)
+		}
+		return total.(int) + sumPair(pair.(ezarr.Tuple))
+	})
+	if got := take(t, sums, 4); !ezarr.Equal(got.Elements[1:], []interface{}{7, 12, 21}) {
+		t.Errorf("Expected running sums [7, 12, 21], got %v", got.Elements[1:])
+	}
+}
+
+func sumPair(pair ezarr.Tuple) int {
+	a, _ := pair.Get(0)
+	b, _ := pair.Get(1)
+	return a.(int) + b.(int)
+}
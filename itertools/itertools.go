@@ -0,0 +1,363 @@
+// Package itertools provides lazy iterators modelled on Python's itertools
+// module. The functions consume an Iterable, such as an *ezarr.List or an
+// iter.Seq wrapped in Seq, and return a Seq that computes its elements only
+// as they are ranged over, so calls can be chained freely. Results that
+// Python yields as tuples are ezarr.Tuple values.
+package itertools
+
+import (
+	"iter"
+
+	"github.com/NovaDAndrew/ezarr"
+)
+
+// Iterable is anything with a Values iterator: *ezarr.List, ezarr.Tuple,
+// *ezarr.Deque, *ezarr.SortedList and Seq.
+type Iterable interface {
+	Values() iter.Seq[interface{}]
+}
+
+// Seq is an iter.Seq that is also an Iterable. Convert an iter.Seq to Seq
+// to pass it to these functions; the Seqs they return can be ranged over
+// directly.
+type Seq iter.Seq[interface{}]
+
+func (s Seq) Values() iter.Seq[interface{}] {
+	return iter.Seq[interface{}](s)
+}
+
+// ToList consumes s and returns its elements. It does not return for an
+// infinite Seq.
+func (s Seq) ToList() *ezarr.List {
+	return ezarr.Collect(s.Values())
+}
+
+// Count yields start, start+step, start+2*step and so on, forever.
+func Count(start, step int) Seq {
+	return func(yield func(interface{}) bool) {
+		for n := start; yield(n); n += step {
+		}
+	}
+}
+
+// Cycle yields the elements of it over and over. The elements are saved on
+// the first pass, so it is consumed only once.
+func Cycle(it Iterable) Seq {
+	return func(yield func(interface{}) bool) {
+		var saved []interface{}
+		for v := range it.Values() {
+			if !yield(v) {
+				return
+			}
+			saved = append(saved, v)
+		}
+		for len(saved) > 0 {
+			for _, v := range saved {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Repeat yields v times times, or forever when times is ezarr.Omit.
+func Repeat(v interface{}, times int) Seq {
+	return func(yield func(interface{}) bool) {
+		for i := 0; times == ezarr.Omit || i < times; i++ {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Accumulate yields the running results of f, starting with the first
+// element: it, f(it[0], it[1]), f(f(it[0], it[1]), it[2]) and so on.
+func Accumulate(it Iterable, f func(total, element interface{}) interface{}) Seq {
+	return func(yield func(interface{}) bool) {
+		var total interface{}
+		first := true
+		for v := range it.Values() {
+			if first {
+				total, first = v, false
+			} else {
+				total = f(total, v)
+			}
+			if !yield(total) {
+				return
+			}
+		}
+	}
+}
+
+// Batched yields the elements of it as Tuples of n elements. The last
+// Tuple may be shorter. It returns a ValueError when n is less than one.
+func Batched(it Iterable, n int) (Seq, error) {
+	if n < 1 {
+		return nil, &ezarr.ValueError{Value: n, Msg: "n must be at least one"}
+	}
+	return func(yield func(interface{}) bool) {
+		batch := make([]interface{}, 0, n)
+		for v := range it.Values() {
+			batch = append(batch, v)
+			if len(batch) == n {
+				if !yield(ezarr.NewTuple(batch...)) {
+					return
+				}
+				batch = batch[:0]
+			}
+		}
+		if len(batch) > 0 {
+			yield(ezarr.NewTuple(batch...))
+		}
+	}, nil
+}
+
+// Chain yields the elements of each Iterable in turn.
+func Chain(its ...Iterable) Seq {
+	return func(yield func(interface{}) bool) {
+		for _, it := range its {
+			for v := range it.Values() {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Compress yields the elements of data whose matching selector is true,
+// as decided by ezarr.Truth. It stops when either runs out.
+func Compress(data, selectors Iterable) Seq {
+	return func(yield func(interface{}) bool) {
+		next, stop := iter.Pull(selectors.Values())
+		defer stop()
+		for v := range data.Values() {
+			selector, ok := next()
+			if !ok {
+				return
+			}
+			if ezarr.Truth(selector) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhile skips elements while predicate is true and then yields every
+// remaining element.
+func DropWhile(it Iterable, predicate func(interface{}) bool) Seq {
+	return func(yield func(interface{}) bool) {
+		dropping := true
+		for v := range it.Values() {
+			if dropping && predicate(v) {
+				continue
+			}
+			dropping = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile yields elements while predicate is true. The first element for
+// which it is false is consumed but not yielded.
+func TakeWhile(it Iterable, predicate func(interface{}) bool) Seq {
+	return func(yield func(interface{}) bool) {
+		for v := range it.Values() {
+			if !predicate(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FilterFalse yields the elements for which predicate is false. A nil
+// predicate tests the elements themselves with ezarr.Truth.
+func FilterFalse(it Iterable, predicate func(interface{}) bool) Seq {
+	if predicate == nil {
+		predicate = ezarr.Truth
+	}
+	return func(yield func(interface{}) bool) {
+		for v := range it.Values() {
+			if !predicate(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// GroupBy yields each run of consecutive elements with Equal keys as the
+// key and a List of the run. A nil key groups the elements themselves.
+// Only one group is held in memory at a time.
+func GroupBy(it Iterable, key func(interface{}) interface{}) iter.Seq2[interface{}, *ezarr.List] {
+	if key == nil {
+		key = func(v interface{}) interface{} { return v }
+	}
+	return func(yield func(interface{}, *ezarr.List) bool) {
+		var current interface{}
+		var group *ezarr.List
+		for v := range it.Values() {
+			k := key(v)
+			if group != nil && ezarr.Equal(k, current) {
+				group.Append(v)
+				continue
+			}
+			if group != nil && !yield(current, group) {
+				return
+			}
+			current, group = k, ezarr.New(v)
+		}
+		if group != nil {
+			yield(current, group)
+		}
+	}
+}
+
+// Islice yields the elements of it from start up to stop, taking every
+// step-th one, like Python's it[start:stop:step] on an iterator. Omit
+// leaves a bound out, and no element past stop is consumed. It returns a
+// ValueError for negative bounds or a step below one.
+func Islice(it Iterable, start, stop, step int) (Seq, error) {
+	if start == ezarr.Omit {
+		start = 0
+	}
+	if step == ezarr.Omit {
+		step = 1
+	}
+	if start < 0 || stop < 0 && stop != ezarr.Omit {
+		return nil, &ezarr.ValueError{Msg: "indices for Islice must be non-negative or Omit"}
+	}
+	if step < 1 {
+		return nil, &ezarr.ValueError{Value: step, Msg: "step for Islice must be a positive integer or Omit"}
+	}
+
+	return func(yield func(interface{}) bool) {
+		if stop != ezarr.Omit && start >= stop {
+			return
+		}
+		i := 0
+		for v := range it.Values() {
+			if i >= start && (i-start)%step == 0 && !yield(v) {
+				return
+			}
+			i++
+			if stop != ezarr.Omit && i >= stop {
+				return
+			}
+		}
+	}, nil
+}
+
+// Pairwise yields each pair of consecutive elements as a Tuple.
+func Pairwise(it Iterable) Seq {
+	return func(yield func(interface{}) bool) {
+		var previous interface{}
+		first := true
+		for v := range it.Values() {
+			if !first && !yield(ezarr.NewTuple(previous, v)) {
+				return
+			}
+			previous, first = v, false
+		}
+	}
+}
+
+// Tee returns n Seqs that each yield the elements of it, which is consumed
+// only once. Elements are buffered until every Seq has yielded them. Each
+// Seq continues from where it stopped when ranged over again.
+//
+// Tee also returns a stop function that releases it when the Seqs are
+// abandoned before one of them reaches the end, like the stop function of
+// iter.Pull. After stop the Seqs yield only what they have buffered. Calling
+// stop more than once, or after the end, does nothing.
+func Tee(it Iterable, n int) ([]Seq, func(), error) {
+	if n < 0 {
+		return nil, nil, &ezarr.ValueError{Value: n, Msg: "n must be non-negative"}
+	}
+
+	var next func() (interface{}, bool)
+	var release func()
+	done := false
+	queues := make([][]interface{}, n)
+
+	stop := func() {
+		done = true
+		if release != nil {
+			release()
+		}
+	}
+
+	seqs := make([]Seq, n)
+	for i := range seqs {
+		seqs[i] = func(yield func(interface{}) bool) {
+			for {
+				if len(queues[i]) > 0 {
+					v := queues[i][0]
+					queues[i][0] = nil
+					queues[i] = queues[i][1:]
+					if !yield(v) {
+						return
+					}
+					continue
+				}
+				if done {
+					return
+				}
+				if next == nil {
+					next, release = iter.Pull(it.Values())
+				}
+				v, ok := next()
+				if !ok {
+					stop()
+					return
+				}
+				for j := range queues {
+					if j != i {
+						queues[j] = append(queues[j], v)
+					}
+				}
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+	return seqs, stop, nil
+}
+
+// ZipLongest yields Tuples of the elements of its at each position,
+// filling in fill for Iterables that have run out, until all have.
+func ZipLongest(fill interface{}, its ...Iterable) Seq {
+	return func(yield func(interface{}) bool) {
+		nexts := make([]func() (interface{}, bool), len(its))
+		for i, it := range its {
+			next, stop := iter.Pull(it.Values())
+			defer stop()
+			nexts[i] = next
+		}
+
+		active := len(its)
+		for active > 0 {
+			row := make([]interface{}, len(its))
+			for i, next := range nexts {
+				if next == nil {
+					row[i] = fill
+					continue
+				}
+				v, ok := next()
+				if !ok {
+					nexts[i] = nil
+					active--
+					v = fill
+				}
+				row[i] = v
+			}
+			if active == 0 || !yield(ezarr.NewTuple(row...)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package itertools
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NovaDAndrew/ezarr"
+)
+
+func take(t *testing.T, s Seq, n int) *ezarr.List {
+	t.Helper()
+	it, err := Islice(s, ezarr.Omit, n, ezarr.Omit)
+	if err != nil {
+		t.Fatalf("Islice returned error: %v", err)
+	}
+	return it.ToList()
+}
+
+func check(t *testing.T, name string, got *ezarr.List, expected string) {
+	t.Helper()
+	if ezarr.Repr(got) != expected {
+		t.Errorf("%s: expected %s, got %s", name, expected, ezarr.Repr(got))
+	}
+}
+
+// Test | Infinite iterators verify Count, Cycle and Repeat
+func TestInfinite(t *testing.T) {
+	check(t, "Count", take(t, Count(10, -3), 4), "[10, 7, 4, 1]")
+	check(t, "Cycle", take(t, Cycle(ezarr.New("a", "b")), 5), "['a', 'b', 'a', 'b', 'a']")
+	check(t, "Cycle empty", take(t, Cycle(ezarr.New()), 5), "[]")
+	check(t, "Repeat", Repeat("x", 3).ToList(), "['x', 'x', 'x']")
+	check(t, "Repeat forever", take(t, Repeat(0, ezarr.Omit), 2), "[0, 0]")
+	check(t, "Repeat negative", Repeat(0, -1).ToList(), "[]")
+}
+
+// Test | Terminating iterators verify results against Python
+func TestTerminating(t *testing.T) {
+	numbers := ezarr.New(1, 2, 3, 4, 5, 1)
+	small := func(v interface{}) bool { return v.(int) < 3 }
+
+	product := func(a, b interface{}) interface{} { return a.(int) * b.(int) }
+	check(t, "Accumulate", Accumulate(ezarr.New(1, 2, 3, 4), product).ToList(), "[1, 2, 6, 24]")
+	check(t, "Chain", Chain(ezarr.New(1), ezarr.NewTuple(2, 3), Repeat(4, 1)).ToList(), "[1, 2, 3, 4]")
+	check(t, "Compress", Compress(ezarr.New("a", "b", "c", "d"), ezarr.New(1, 0, "x")).ToList(), "['a', 'c']")
+	check(t, "DropWhile", DropWhile(numbers, small).ToList(), "[3, 4, 5, 1]")
+	check(t, "TakeWhile", TakeWhile(numbers, small).ToList(), "[1, 2]")
+	check(t, "FilterFalse", FilterFalse(numbers, small).ToList(), "[3, 4, 5]")
+	check(t, "FilterFalse nil", FilterFalse(ezarr.New(0, 1, "", "a", nil), nil).ToList(), "[0, '', None]")
+	check(t, "Pairwise", Pairwise(ezarr.New(1, 2, 3)).ToList(), "[(1, 2), (2, 3)]")
+	check(t, "ZipLongest", ZipLongest(0, ezarr.New(1, 2, 3), ezarr.New("a", "b")).ToList(), "[(1, 'a'), (2, 'b'), (3, 0)]")
+
+	batched, err := Batched(Count(1, 1), 2)
+	if err != nil {
+		t.Fatalf("Batched returned error: %v", err)
+	}
+	check(t, "Batched", take(t, batched, 2), "[(1, 2), (3, 4)]")
+	short, _ := Batched(ezarr.New(1, 2, 3), 2)
+	check(t, "Batched short", short.ToList(), "[(1, 2), (3,)]")
+	if _, err := Batched(numbers, 0); !errors.Is(err, ezarr.ErrValue) {
+		t.Errorf("Expected ValueError for n < 1, got %v", err)
+	}
+}
+
+// Test | Islice verifies bounds against Python and that it stops consuming at stop
+func TestIslice(t *testing.T) {
+	cases := []struct {
+		start, stop, step int
+		expected          string
+	}{
+		{2, 12, 3, "[2, 5, 8, 11]"},
+		{ezarr.Omit, 3, ezarr.Omit, "[0, 1, 2]"},
+		{17, ezarr.Omit, ezarr.Omit, "[17, 18, 19]"},
+		{5, 5, 1, "[]"},
+	}
+	for _, c := range cases {
+		s, err := Islice(ezarr.RangeTo(20).ToList(), c.start, c.stop, c.step)
+		if err != nil {
+			t.Fatalf("Islice returned error: %v", err)
+		}
+		check(t, "Islice", s.ToList(), c.expected)
+	}
+
+	consumed := 0
+	counting := Seq(func(yield func(interface{}) bool) {
+		for i := 0; ; i++ {
+			consumed++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+	s, _ := Islice(counting, 0, 3, 1)
+	s.ToList()
+	if consumed != 3 {
+		t.Errorf("Expected 3 elements to be consumed, got %d", consumed)
+	}
+
+	for _, bounds := range [][3]int{{-1, 3, 1}, {0, -2, 1}, {0, 3, 0}} {
+		if _, err := Islice(counting, bounds[0], bounds[1], bounds[2]); !errors.Is(err, ezarr.ErrValue) {
+			t.Errorf("Expected ValueError for %v, got %v", bounds, err)
+		}
+	}
+}
+
+// Test | GroupBy verifies runs of equal keys
+func TestGroupBy(t *testing.T) {
+	var keys []interface{}
+	var groups []string
+	for k, g := range GroupBy(ezarr.New("apple", "avocado", "banana", "blueberry", "apricot"), func(v interface{}) interface{} {
+		return v.(string)[:1]
+	}) {
+		keys = append(keys, k)
+		groups = append(groups, ezarr.Repr(g))
+	}
+	if !ezarr.Equal(keys, []interface{}{"a", "b", "a"}) {
+		t.Errorf("Unexpected keys: %v", keys)
+	}
+	if groups[0] != "['apple', 'avocado']" || groups[2] != "['apricot']" {
+		t.Errorf("Unexpected groups: %v", groups)
+	}
+
+	runs := 0
+	for range GroupBy(ezarr.New(1, 1, 2, 2, 2, 1), nil) {
+		runs++
+	}
+	if runs != 3 {
+		t.Errorf("Expected 3 runs, got %d", runs)
+	}
+}
+
+// Test | Tee verifies independent iterators over a source consumed once
+func TestTee(t *testing.T) {
+	pulls := 0
+	source := Seq(func(yield func(interface{}) bool) {
+		for i := 1; i <= 4; i++ {
+			pulls++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	seqs, stop, err := Tee(source, 2)
+	if err != nil {
+		t.Fatalf("Tee returned error: %v", err)
+	}
+	defer stop()
+	check(t, "first two", take(t, seqs[0], 2), "[1, 2]")
+	check(t, "second", seqs[1].ToList(), "[1, 2, 3, 4]")
+	check(t, "first rest", seqs[0].ToList(), "[3, 4]")
+	if pulls != 4 {
+		t.Errorf("Expected the source to be consumed once, got %d pulls", pulls)
+	}
+
+	if _, _, err := Tee(source, -1); !errors.Is(err, ezarr.ErrValue) {
+		t.Errorf("Expected ValueError for n < 0, got %v", err)
+	}
+
+	released := false
+	endless := Seq(func(yield func(interface{}) bool) {
+		defer func() { released = true }()
+		for i := 0; yield(i); i++ {
+		}
+	})
+	seqs, stop, _ = Tee(endless, 2)
+	check(t, "abandoned", take(t, seqs[0], 3), "[0, 1, 2]")
+	stop()
+	if !released {
+		t.Error("Expected stop to release the source")
+	}
+	check(t, "after stop", seqs[1].ToList(), "[0, 1, 2]")
+	stop()
+}
@@ -0,0 +1,506 @@
+package ezarr
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// JSONOptions controls how JSON documents are decoded into Lists and Dicts.
+type JSONOptions struct {
+	// IntNumbers decodes numbers written without a fraction or exponent as
+	// int (or *big.Int when they overflow) instead of float64.
+	IntNumbers bool
+}
+
+// DecodeJSON decodes a JSON document with the default options. Objects
+// become *Dict in document order, arrays become *List.
+func DecodeJSON(data []byte) (interface{}, error) {
+	return JSONOptions{}.Decode(data)
+}
+
+func (o JSONOptions) Decode(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	value, err := o.decodeValue(dec)
+	if err == nil {
+		if _, err = dec.Token(); err == io.EOF {
+			return value, nil
+		}
+	}
+	return nil, decodeError(data, err)
+}
+
+// decodeError turns the syntax errors of encoding/json, and a nil err for
+// data left after the value, into ValueErrors, as Python's json module
+// raises JSONDecodeError, a ValueError. The message and offset are the ones
+// Python gives. Other errors are returned unchanged.
+func decodeError(data []byte, err error) error {
+	var syntaxErr *json.SyntaxError
+	if err != nil && err != io.ErrUnexpectedEOF && !errors.As(err, &syntaxErr) {
+		return err
+	}
+	if offset, msg, ok := scanJSONError(data); ok {
+		return jsonError(data, offset, msg)
+	}
+	if syntaxErr != nil {
+		// Offset counts the bytes read, which includes the offending
+		// character unless the input ended early.
+		offset := int(syntaxErr.Offset)
+		if offset < len(data) || !strings.HasPrefix(syntaxErr.Error(), "unexpected end") {
+			offset--
+		}
+		return jsonError(data, max(offset, 0), syntaxErr.Error())
+	}
+	return jsonError(data, len(data), "Expecting value")
+}
+
+// jsonScanner checks JSON syntax the way Python's json module parses it,
+// to find the first error and the offset Python reports it at.
+type jsonScanner struct {
+	data []byte
+	pos  int
+	msg  string
+}
+
+// scanJSONError returns the offset and message of the first syntax error in
+// data, and false when there is none.
+func scanJSONError(data []byte) (int, string, bool) {
+	s := &jsonScanner{data: data}
+	if !s.value() {
+		return s.pos, s.msg, true
+	}
+	s.skipSpace()
+	if s.pos < len(data) {
+		return s.pos, "Extra data", true
+	}
+	return 0, "", false
+}
+
+func (s *jsonScanner) fail(pos int, msg string) bool {
+	s.pos, s.msg = pos, msg
+	return false
+}
+
+func (s *jsonScanner) peek() byte {
+	if s.pos < len(s.data) {
+		return s.data[s.pos]
+	}
+	return 0
+}
+
+func (s *jsonScanner) skipSpace() {
+	for s.pos < len(s.data) && strings.IndexByte(" \t\r\n", s.data[s.pos]) >= 0 {
+		s.pos++
+	}
+}
+
+func (s *jsonScanner) value() bool {
+	s.skipSpace()
+	switch c := s.peek(); {
+	case c == '"':
+		return s.string()
+	case c == '[':
+		return s.array()
+	case c == '{':
+		return s.object()
+	case c == '-' || isDigit(c):
+		return s.number()
+	}
+	for _, literal := range []string{"null", "true", "false"} {
+		if bytes.HasPrefix(s.data[s.pos:], []byte(literal)) {
+			s.pos += len(literal)
+			return true
+		}
+	}
+	return s.fail(s.pos, "Expecting value")
+}
+
+func (s *jsonScanner) array() bool {
+	s.pos++
+	s.skipSpace()
+	if s.peek() == ']' {
+		s.pos++
+		return true
+	}
+	for {
+		if !s.value() {
+			return false
+		}
+		s.skipSpace()
+		switch s.peek() {
+		case ']':
+			s.pos++
+			return true
+		case ',':
+			s.pos++
+		default:
+			return s.fail(s.pos, "Expecting ',' delimiter")
+		}
+	}
+}
+
+func (s *jsonScanner) object() bool {
+	s.pos++
+	s.skipSpace()
+	if s.peek() == '}' {
+		s.pos++
+		return true
+	}
+	for {
+		s.skipSpace()
+		if s.peek() != '"' {
+			return s.fail(s.pos, "Expecting property name enclosed in double quotes")
+		}
+		if !s.string() {
+			return false
+		}
+		s.skipSpace()
+		if s.peek() != ':' {
+			return s.fail(s.pos, "Expecting ':' delimiter")
+		}
+		s.pos++
+		if !s.value() {
+			return false
+		}
+		s.skipSpace()
+		switch s.peek() {
+		case '}':
+			s.pos++
+			return true
+		case ',':
+			s.pos++
+		default:
+			return s.fail(s.pos, "Expecting ',' delimiter")
+		}
+	}
+}
+
+func (s *jsonScanner) string() bool {
+	start := s.pos
+	for s.pos++; s.pos < len(s.data); {
+		switch c := s.data[s.pos]; {
+		case c == '"':
+			s.pos++
+			return true
+		case c < 0x20:
+			return s.fail(s.pos, "Invalid control character at")
+		case c != '\\':
+			s.pos++
+		case s.pos+1 == len(s.data):
+			return s.fail(start, "Unterminated string starting at")
+		case s.data[s.pos+1] == 'u':
+			if s.pos+6 > len(s.data) || !isHexString(s.data[s.pos+2:s.pos+6]) {
+				return s.fail(s.pos, "Invalid \\uXXXX escape")
+			}
+			s.pos += 6
+		case strings.IndexByte(`"\/bfnrt`, s.data[s.pos+1]) >= 0:
+			s.pos += 2
+		default:
+			return s.fail(s.pos, "Invalid \\escape")
+		}
+	}
+	return s.fail(start, "Unterminated string starting at")
+}
+
+// number matches -?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][-+]?[0-9]+)? like Python,
+// leaving whatever follows the longest match to the caller.
+func (s *jsonScanner) number() bool {
+	start := s.pos
+	if s.peek() == '-' {
+		s.pos++
+	}
+	switch c := s.peek(); {
+	case c == '0':
+		s.pos++
+	case isDigit(c):
+		s.digits()
+	default:
+		return s.fail(start, "Expecting value")
+	}
+	if s.peek() == '.' && s.pos+1 < len(s.data) && isDigit(s.data[s.pos+1]) {
+		s.pos++
+		s.digits()
+	}
+	if c := s.peek(); c == 'e' || c == 'E' {
+		exp := s.pos + 1
+		if exp < len(s.data) && (s.data[exp] == '+' || s.data[exp] == '-') {
+			exp++
+		}
+		if exp < len(s.data) && isDigit(s.data[exp]) {
+			s.pos = exp
+			s.digits()
+		}
+	}
+	return true
+}
+
+func (s *jsonScanner) digits() {
+	for isDigit(s.peek()) {
+		s.pos++
+	}
+}
+
+func isHexString(b []byte) bool {
+	for _, c := range b {
+		if !isHexDigit(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// jsonError returns a ValueError for the character at offset, with its
+// Value set to the offset and a message worded like Python's.
+func jsonError(data []byte, offset int, msg string) error {
+	line := 1 + bytes.Count(data[:offset], []byte("\n"))
+	column := offset - bytes.LastIndexByte(data[:offset], '\n')
+	return &ValueError{
+		Value: offset,
+		Msg:   fmt.Sprintf("%s: line %d column %d (char %d)", msg, line, column, offset),
+	}
+}
+
+func (o JSONOptions) decodeValue(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	switch tok := tok.(type) {
+	case json.Delim:
+		switch tok {
+		case '[':
+			list := &List{Elements: []interface{}{}}
+			for dec.More() {
+				value, err := o.decodeValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				list.Elements = append(list.Elements, value)
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+			return list, nil
+		case '{':
+			dict := &Dict{Keys: []interface{}{}, Values: []interface{}{}}
+			for dec.More() {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := o.decodeValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				dict.Set(keyTok.(string), value)
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+			return dict, nil
+		}
+	case json.Number:
+		return o.decodeNumber(tok)
+	}
+	return tok, nil
+}
+
+func (o JSONOptions) decodeNumber(n json.Number) (interface{}, error) {
+	text := n.String()
+	if o.IntNumbers && !strings.ContainsAny(text, ".eE") {
+		i, ok := new(big.Int).SetString(text, 10)
+		if !ok {
+			return nil, &ValueError{Value: text, Msg: fmt.Sprintf("invalid number %s", text)}
+		}
+		return normalizeInt(i), nil
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return nil, &ValueError{Value: text, Msg: fmt.Sprintf("invalid number %s", text)}
+	}
+	return f, nil
+}
+
+func (l *List) MarshalJSON() ([]byte, error) {
+	return marshalJSON(l)
+}
+
+func (c *Counter) MarshalJSON() ([]byte, error) {
+	return marshalJSON(c)
+}
+
+func (d *Deque) MarshalJSON() ([]byte, error) {
+	return marshalJSON(d)
+}
+
+func (s *SortedList) MarshalJSON() ([]byte, error) {
+	return marshalJSON(s)
+}
+
+func (d *SortedDict) MarshalJSON() ([]byte, error) {
+	return marshalJSON(d)
+}
+
+func (t Tuple) MarshalJSON() ([]byte, error) {
+	return marshalJSON(t)
+}
+
+func marshalJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeJSON(&buf, v, map[visit]bool{}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeJSON encodes v, following the containers of this package itself so
+// that one that contains itself returns a ValueError, as in Python, instead
+// of recursing until the stack overflows. active holds the containers being
+// encoded; other values are left to encoding/json.
+func writeJSON(buf *bytes.Buffer, v interface{}, active map[visit]bool) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		buf.WriteString("null")
+		return nil
+	}
+
+	var elements []interface{}
+	var dict *Dict
+	switch c := v.(type) {
+	case *List:
+		elements = c.Elements
+	case Tuple:
+		elements = c.elements
+	case *Deque:
+		elements = c.ToList().Elements
+	case *SortedList:
+		elements = c.ToList().Elements
+	case *Dict:
+		dict = c
+	case *Counter:
+		dict = &c.counts
+	case *SortedDict:
+		dict = c.ToDict()
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		return nil
+	}
+
+	key, cyclic := enter(rv, active)
+	if cyclic {
+		return &ValueError{Value: v, Msg: "Circular reference detected"}
+	}
+	defer delete(active, key)
+
+	if dict == nil {
+		buf.WriteByte('[')
+		for i, e := range elements {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSON(buf, e, active); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	}
+
+	buf.WriteByte('{')
+	for i, key := range dict.Keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := jsonKey(key)
+		if err != nil {
+			return err
+		}
+		keyData, _ := json.Marshal(name)
+		buf.Write(keyData)
+		buf.WriteByte(':')
+		if err := writeJSON(buf, dict.Values[i], active); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func (l *List) UnmarshalJSON(data []byte) error {
+	value, err := DecodeJSON(data)
+	if err != nil {
+		return err
+	}
+	list, ok := value.(*List)
+	if !ok {
+		return &TypeError{Value: value, Msg: fmt.Sprintf("cannot unmarshal JSON %s into List", pyTypeName(value))}
+	}
+	l.Elements = list.Elements
+	return nil
+}
+
+func (d *Dict) MarshalJSON() ([]byte, error) {
+	return marshalJSON(d)
+}
+
+func (d *Dict) UnmarshalJSON(data []byte) error {
+	value, err := DecodeJSON(data)
+	if err != nil {
+		return err
+	}
+	dict, ok := value.(*Dict)
+	if !ok {
+		return &TypeError{Value: value, Msg: fmt.Sprintf("cannot unmarshal JSON %s into Dict", pyTypeName(value))}
+	}
+	*d = *dict
+	return nil
+}
+
+// jsonKey converts a Dict key to a JSON object name using the same rules
+// as Python's json module.
+func jsonKey(key interface{}) (string, error) {
+	if tm, ok := key.(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+
+	v := reflect.ValueOf(key)
+	if !v.IsValid() {
+		return "null", nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32:
+		return reprFloat(v.Float(), 32), nil
+	case reflect.Float64:
+		return reprFloat(v.Float(), 64), nil
+	}
+	return "", &TypeError{
+		Value: key,
+		Msg:   fmt.Sprintf("keys must be str, int, float, bool or None, not %s", pyTypeName(key)),
+	}
+}
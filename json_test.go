@@ -0,0 +1,156 @@
+package ezarr
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// Test | MarshalJSON verifies that Dicts encode as objects in insertion order
+func TestMarshalJSON(t *testing.T) {
+	inner, _ := NewDict("z", 1, "a", New(true, nil))
+	dict, _ := NewDict("name", "Puer", "age", 18, "nested", inner, 3, 1.5, true, "yes", nil, "none")
+
+	data, err := json.Marshal(dict)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `{"name":"Puer","age":18,"nested":{"z":1,"a":[true,null]},"3":1.5,"true":"yes","null":"none"}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	data, err = json.Marshal(New(1, "two", New(), &Dict{}))
+	if err != nil || string(data) != `[1,"two",[],{}]` {
+		t.Errorf("Expected [1,\"two\",[],{}], got %s, error: %v", data, err)
+	}
+
+	bad := &Dict{}
+	bad.Set([]interface{}{1}, "tuple key")
+	if _, err := json.Marshal(bad); err == nil {
+		t.Error("Expected error for unsupported key type, got nil")
+	}
+}
+
+// Test | MarshalJSON verifies that a container holding itself is a ValueError and a shared one is not
+func TestMarshalJSONCircular(t *testing.T) {
+	shared := New(1)
+	if data, err := json.Marshal(New(shared, shared)); err != nil || string(data) != "[[1],[1]]" {
+		t.Errorf("Expected [[1],[1]] for a shared list, got %s, error: %v", data, err)
+	}
+
+	l := New(1)
+	l.Append(l)
+	if _, err := l.MarshalJSON(); !errors.Is(err, ErrValue) || err.Error() != "Circular reference detected" {
+		t.Errorf("Expected ValueError for a list holding itself, got %v", err)
+	}
+	d := &Dict{}
+	d.Set("self", New(d))
+	if _, err := json.Marshal(d); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError for a dict holding itself, got %v", err)
+	}
+	q := NewDeque(0)
+	q.Append(NewTuple(q))
+	if _, err := json.Marshal(q); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError for a deque holding itself, got %v", err)
+	}
+}
+
+// Test | UnmarshalJSON verifies decoding objects and arrays in document order
+func TestUnmarshalJSON(t *testing.T) {
+	var dict Dict
+	err := json.Unmarshal([]byte(`{"b": 1, "a": {"y": [1, {"k": null}], "x": false}, "b": 2}`), &dict)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if Repr(&dict) != "{'b': 2.0, 'a': {'y': [1.0, {'k': None}], 'x': False}}" {
+		t.Errorf("Unexpected decoded dict: %s", Repr(&dict))
+	}
+	if val, _ := dict.Get("b"); val != 2.0 {
+		t.Errorf("Expected lookups to work after decoding, got %v", val)
+	}
+
+	var list List
+	if err := json.Unmarshal([]byte(`[1, "a", [], {}]`), &list); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if Repr(&list) != "[1.0, 'a', [], {}]" {
+		t.Errorf("Unexpected decoded list: %s", Repr(&list))
+	}
+
+	var wrapper struct {
+		Items *List `json:"items"`
+		Meta  *Dict `json:"meta"`
+	}
+	if err := json.Unmarshal([]byte(`{"items": [1], "meta": {"k": "v"}}`), &wrapper); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if wrapper.Items.Len() != 1 || wrapper.Meta.GetDefault("k", nil) != "v" {
+		t.Errorf("Unexpected decoded struct fields: %v, %v", wrapper.Items, wrapper.Meta)
+	}
+
+	if err := json.Unmarshal([]byte(`[1]`), &dict); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError decoding an array into a Dict, got %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"a": }`), &dict); err == nil {
+		t.Error("Expected error for malformed JSON, got nil")
+	}
+}
+
+// Test | JSONOptions verifies decoding integral numbers as int
+func TestJSONOptions(t *testing.T) {
+	value, err := JSONOptions{IntNumbers: true}.Decode([]byte(`[1, -2, 2.0, 1e3, 123456789012345678901234567890]`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	expected := New(1, -2, 2.0, 1000.0, huge)
+	if !Equal(value, expected) {
+		t.Errorf("Expected %s, got %s", Repr(expected), Repr(value))
+	}
+
+	cases := map[string]string{
+		"[1] [2]":   "Extra data: line 1 column 5 (char 4)",
+		"[1] x":     "Extra data: line 1 column 5 (char 4)",
+		"[1]]":      "Extra data: line 1 column 4 (char 3)",
+		"[1,]":      "Expecting value: line 1 column 4 (char 3)",
+		"[1,\n  x]": "Expecting value: line 2 column 3 (char 6)",
+		"[1,x":      "Expecting value: line 1 column 4 (char 3)",
+		"":          "Expecting value: line 1 column 1 (char 0)",
+		"tru":       "Expecting value: line 1 column 1 (char 0)",
+		"[1 2]":     "Expecting ',' delimiter: line 1 column 4 (char 3)",
+		`{"a": 1`:   "Expecting ',' delimiter: line 1 column 8 (char 7)",
+		`{"a" 1}`:   "Expecting ':' delimiter: line 1 column 6 (char 5)",
+		`{"a":1,}`:  "Expecting property name enclosed in double quotes: line 1 column 8 (char 7)",
+		`["abc`:     "Unterminated string starting at: line 1 column 2 (char 1)",
+		`["a\qb"]`:  "Invalid \\escape: line 1 column 4 (char 3)",
+	}
+	for src, msg := range cases {
+		_, err := DecodeJSON([]byte(src))
+		var valueErr *ValueError
+		if !errors.Is(err, ErrValue) || !errors.As(err, &valueErr) || err.Error() != msg {
+			t.Errorf("Expected ValueError %q for %q, got %v", msg, src, err)
+		}
+	}
+}
+
+// Test | JSON verifies that marshaling then unmarshaling preserves a Dict
+func TestJSONRoundTrip(t *testing.T) {
+	dict, _ := NewDict("b", New("x", true, nil), "a", &Dict{}, "c", "text")
+
+	data, err := json.Marshal(dict)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var decoded Dict
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !Equal(&decoded, dict) {
+		t.Errorf("Expected %s, got %s", Repr(dict), Repr(&decoded))
+	}
+}
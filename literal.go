@@ -0,0 +1,721 @@
+package ezarr
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseLiteral parses a Python literal the way ast.literal_eval does.
+// Lists become *List, dicts *Dict, sets *Set, tuples Tuple,
+// strings string, bytes []byte, ints int (or *big.Int when they overflow),
+// floats float64 and complex numbers complex128.
+//
+// Every string escape is supported except \N{NAME}: Go has no table of
+// Unicode character names, so it returns a SyntaxError. Write the character
+// itself or use \u or \U instead.
+func ParseLiteral(src string) (interface{}, error) {
+	p := &literalParser{src: src}
+	p.skipSpace()
+
+	value, err := p.parseTopLevel()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unexpected %q", p.peekRune())
+	}
+	return value, nil
+}
+
+// maxLiteralDepth bounds the nesting of brackets and unary operators, as
+// Python's parser bounds nested parentheses at 200.
+const maxLiteralDepth = 200
+
+type literalParser struct {
+	src   string
+	pos   int
+	depth int
+}
+
+func (p *literalParser) errorf(format string, args ...interface{}) error {
+	return p.errorAt(p.pos, format, args...)
+}
+
+func (p *literalParser) errorAt(pos int, format string, args ...interface{}) error {
+	line := 1 + strings.Count(p.src[:pos], "\n")
+	column := pos - strings.LastIndexByte(p.src[:pos], '\n')
+	return &SyntaxError{
+		Offset: pos,
+		Line:   line,
+		Column: column,
+		Msg:    fmt.Sprintf("%s at line %d, column %d", fmt.Sprintf(format, args...), line, column),
+	}
+}
+
+func (p *literalParser) peek() byte {
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+	return 0
+}
+
+func (p *literalParser) peekRune() rune {
+	r, _ := utf8.DecodeRuneInString(p.src[p.pos:])
+	return r
+}
+
+func (p *literalParser) skipSpace() {
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case ' ', '\t', '\n', '\r', '\f':
+			p.pos++
+		case '\\':
+			if strings.HasPrefix(p.src[p.pos:], "\\\n") {
+				p.pos += 2
+				continue
+			}
+			return
+		case '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *literalParser) consume(c byte) bool {
+	p.skipSpace()
+	if p.peek() == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *literalParser) expect(c byte) error {
+	if !p.consume(c) {
+		if p.pos >= len(p.src) {
+			return p.errorf("expected %q, got end of input", c)
+		}
+		return p.errorf("expected %q, got %q", c, p.peekRune())
+	}
+	return nil
+}
+
+// parseTopLevel accepts a bare tuple such as "1, 2" in addition to a
+// single expression, as eval mode does.
+func (p *literalParser) parseTopLevel() (interface{}, error) {
+	if p.pos >= len(p.src) {
+		return nil, p.errorf("unexpected end of input")
+	}
+
+	first, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume(',') {
+		return first, nil
+	}
+
+	elements := []interface{}{first}
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			break
+		}
+		value, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, value)
+		if !p.consume(',') {
+			break
+		}
+	}
+	return newTupleLiteral(elements), nil
+}
+
+// parseExpr handles the only binary operation literal_eval allows: a real
+// number plus or minus an imaginary one.
+func (p *literalParser) parseExpr() (interface{}, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	op := p.peek()
+	if op != '+' && op != '-' {
+		return left, nil
+	}
+	opPos := p.pos
+	p.pos++
+
+	right, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	re, ok := realNumber(left)
+	im, isComplex := right.(complex128)
+	if !ok || !isComplex {
+		return nil, p.errorAt(opPos, "malformed node or string: only a real number plus or minus a complex number is allowed")
+	}
+	if op == '-' {
+		im = -im
+	}
+	return complex(re, 0) + im, nil
+}
+
+func realNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(n).Float64()
+		return f, true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func (p *literalParser) parseUnary() (interface{}, error) {
+	if p.depth == maxLiteralDepth {
+		return nil, &ValueError{Value: p.pos, Msg: fmt.Sprintf("literal nested more than %d levels deep", maxLiteralDepth)}
+	}
+	p.depth++
+	defer func() { p.depth-- }()
+
+	p.skipSpace()
+	op := p.peek()
+	if op != '+' && op != '-' {
+		return p.parseAtom()
+	}
+	opPos := p.pos
+	p.pos++
+
+	operand, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if op == '+' {
+		switch operand.(type) {
+		case int, *big.Int, float64, complex128:
+			return operand, nil
+		}
+		return nil, p.errorAt(opPos, "malformed node or string: unary + on %s", pyTypeName(operand))
+	}
+
+	switch n := operand.(type) {
+	case int:
+		if n == -n && n != 0 {
+			return new(big.Int).Neg(big.NewInt(int64(n))), nil
+		}
+		return -n, nil
+	case *big.Int:
+		return normalizeInt(new(big.Int).Neg(n)), nil
+	case float64:
+		return -n, nil
+	case complex128:
+		return -n, nil
+	}
+	return nil, p.errorAt(opPos, "malformed node or string: unary - on %s", pyTypeName(operand))
+}
+
+func (p *literalParser) parseAtom() (interface{}, error) {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return nil, p.errorf("unexpected end of input")
+	}
+
+	c := p.peek()
+	switch {
+	case c == '[':
+		p.pos++
+		elements, err := p.parseElements(']')
+		if err != nil {
+			return nil, err
+		}
+		return &List{Elements: elements}, nil
+	case c == '(':
+		return p.parseParen()
+	case c == '{':
+		return p.parseBrace()
+	case c == '\'' || c == '"':
+		return p.parseStrings()
+	case c >= '0' && c <= '9' || c == '.' && p.pos+1 < len(p.src) && isDigit(p.src[p.pos+1]):
+		return p.parseNumber()
+	case isIdentStart(c):
+		return p.parseName()
+	}
+	return nil, p.errorf("unexpected %q", p.peekRune())
+}
+
+func (p *literalParser) parseElements(closing byte) ([]interface{}, error) {
+	elements := []interface{}{}
+	for {
+		if p.consume(closing) {
+			return elements, nil
+		}
+		value, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, value)
+		if !p.consume(',') {
+			if err := p.expect(closing); err != nil {
+				return nil, err
+			}
+			return elements, nil
+		}
+	}
+}
+
+func (p *literalParser) parseParen() (interface{}, error) {
+	p.pos++
+	if p.consume(')') {
+		return newTupleLiteral([]interface{}{}), nil
+	}
+
+	first, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if p.consume(')') {
+		return first, nil
+	}
+	if err := p.expect(','); err != nil {
+		return nil, err
+	}
+
+	rest, err := p.parseElements(')')
+	if err != nil {
+		return nil, err
+	}
+	return newTupleLiteral(append([]interface{}{first}, rest...)), nil
+}
+
+func (p *literalParser) parseBrace() (interface{}, error) {
+	p.pos++
+	if p.consume('}') {
+		return &Dict{Keys: []interface{}{}, Values: []interface{}{}}, nil
+	}
+
+	first, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.consume(':') {
+		elements := []interface{}{first}
+		if p.consume(',') {
+			rest, err := p.parseElements('}')
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, rest...)
+		} else if err := p.expect('}'); err != nil {
+			return nil, err
+		}
+		for _, e := range elements {
+			if err := checkHashable(e); err != nil {
+				return nil, err
+			}
+		}
+		return NewSet(elements...), nil
+	}
+
+	dict := &Dict{Keys: []interface{}{}, Values: []interface{}{}}
+	key := first
+	for {
+		if err := checkHashable(key); err != nil {
+			return nil, err
+		}
+		value, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		dict.Set(key, value)
+
+		if !p.consume(',') {
+			if err := p.expect('}'); err != nil {
+				return nil, err
+			}
+			return dict, nil
+		}
+		if p.consume('}') {
+			return dict, nil
+		}
+		if key, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+		if err := p.expect(':'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *literalParser) parseName() (interface{}, error) {
+	start := p.pos
+	for p.pos < len(p.src) && (isIdentStart(p.src[p.pos]) || isDigit(p.src[p.pos])) {
+		p.pos++
+	}
+	name := p.src[start:p.pos]
+
+	if p.peek() == '\'' || p.peek() == '"' {
+		p.pos = start
+		return p.parseStrings()
+	}
+
+	switch name {
+	case "None":
+		return nil, nil
+	case "True":
+		return true, nil
+	case "False":
+		return false, nil
+	case "set":
+		if p.consume('(') {
+			if err := p.expect(')'); err != nil {
+				return nil, err
+			}
+			return &Set{}, nil
+		}
+	}
+	return nil, p.errorAt(start, "malformed node or string: name %q", name)
+}
+
+// checkHashable returns the TypeError Python raises for a set element or
+// dict key that is a list, dict or set, or a tuple holding one.
+func checkHashable(v interface{}) error {
+	switch v := v.(type) {
+	case *List:
+		return &TypeError{Value: v, Msg: "unhashable type: 'list'"}
+	case *Dict:
+		return &TypeError{Value: v, Msg: "unhashable type: 'dict'"}
+	case *Set:
+		return &TypeError{Value: v, Msg: "unhashable type: 'set'"}
+	case Tuple:
+		for _, e := range v.elements {
+			if err := checkHashable(e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func newTupleLiteral(elements []interface{}) interface{} {
+	return NewTuple(elements...)
+}
+
+func (p *literalParser) parseNumber() (interface{}, error) {
+	start := p.pos
+	src := p.src
+
+	if src[p.pos] == '0' && p.pos+1 < len(src) {
+		base := 0
+		switch src[p.pos+1] {
+		case 'x', 'X':
+			base = 16
+		case 'o', 'O':
+			base = 8
+		case 'b', 'B':
+			base = 2
+		}
+		if base != 0 {
+			p.pos += 2
+			digitsStart := p.pos
+			for p.pos < len(src) && (isHexDigit(src[p.pos]) || src[p.pos] == '_') {
+				p.pos++
+			}
+			digits := src[digitsStart:p.pos]
+			if strings.HasPrefix(digits, "_") {
+				digits = digits[1:]
+			}
+			clean, ok := stripUnderscores(digits, isHexDigit)
+			n, valid := new(big.Int).SetString(clean, base)
+			if !ok || !valid {
+				return nil, p.errorAt(start, "invalid number literal %q", src[start:p.pos])
+			}
+			return normalizeInt(n), nil
+		}
+	}
+
+	isFloat := false
+	p.scanDigits()
+	if p.peek() == '.' {
+		isFloat = true
+		p.pos++
+		p.scanDigits()
+	}
+	if c := p.peek(); c == 'e' || c == 'E' {
+		isFloat = true
+		p.pos++
+		if c := p.peek(); c == '+' || c == '-' {
+			p.pos++
+		}
+		p.scanDigits()
+	}
+
+	text := src[start:p.pos]
+	clean, ok := stripUnderscores(text, isDigit)
+	if !ok {
+		return nil, p.errorAt(start, "invalid number literal %q", text)
+	}
+
+	if c := p.peek(); c == 'j' || c == 'J' {
+		p.pos++
+		f, err := strconv.ParseFloat(clean, 64)
+		if err != nil && !isRangeError(err) {
+			return nil, p.errorAt(start, "invalid number literal %q", text)
+		}
+		return complex(0, f), nil
+	}
+
+	if isFloat {
+		f, err := strconv.ParseFloat(clean, 64)
+		if err != nil && !isRangeError(err) {
+			return nil, p.errorAt(start, "invalid number literal %q", text)
+		}
+		return f, nil
+	}
+
+	if len(clean) > 1 && clean[0] == '0' && strings.Trim(clean, "0") != "" {
+		return nil, p.errorAt(start, "leading zeros in decimal integer literals are not permitted")
+	}
+	n, valid := new(big.Int).SetString(clean, 10)
+	if !valid {
+		return nil, p.errorAt(start, "invalid number literal %q", text)
+	}
+	return normalizeInt(n), nil
+}
+
+func (p *literalParser) scanDigits() {
+	for p.pos < len(p.src) && (isDigit(p.src[p.pos]) || p.src[p.pos] == '_') {
+		p.pos++
+	}
+}
+
+func isRangeError(err error) bool {
+	numErr, ok := err.(*strconv.NumError)
+	return ok && numErr.Err == strconv.ErrRange
+}
+
+// stripUnderscores removes digit separators, which are only valid between
+// two digits as told by digit: "1_e5" and "1e_5" are not valid decimals.
+func stripUnderscores(s string, digit func(byte) bool) (string, bool) {
+	if !strings.Contains(s, "_") {
+		return s, true
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '_' {
+			if i == 0 || i == len(s)-1 || !digit(s[i-1]) || !digit(s[i+1]) {
+				return "", false
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String(), true
+}
+
+func normalizeInt(n *big.Int) interface{} {
+	if n.IsInt64() && int64(int(n.Int64())) == n.Int64() {
+		return int(n.Int64())
+	}
+	return n
+}
+
+func (p *literalParser) parseStrings() (interface{}, error) {
+	var text strings.Builder
+	isBytes := false
+
+	for count := 0; ; count++ {
+		p.skipSpace()
+		prefixStart := p.pos
+		for p.pos < len(p.src) && isIdentStart(p.src[p.pos]) {
+			p.pos++
+		}
+		prefix := strings.ToLower(p.src[prefixStart:p.pos])
+		if c := p.peek(); c != '\'' && c != '"' {
+			p.pos = prefixStart
+			if count == 0 {
+				return nil, p.errorf("expected string")
+			}
+			break
+		}
+
+		raw, b := false, false
+		switch prefix {
+		case "", "u":
+		case "r":
+			raw = true
+		case "b":
+			b = true
+		case "br", "rb":
+			raw, b = true, true
+		default:
+			return nil, p.errorAt(prefixStart, "unsupported string prefix %q", p.src[prefixStart:p.pos])
+		}
+		if count > 0 && b != isBytes {
+			return nil, p.errorAt(prefixStart, "cannot mix bytes and nonbytes literals")
+		}
+		isBytes = b
+
+		if err := p.parseString(&text, raw, b); err != nil {
+			return nil, err
+		}
+
+		save := p.pos
+		p.skipSpace()
+		if c := p.peek(); c != '\'' && c != '"' && !isIdentStart(c) {
+			p.pos = save
+			break
+		}
+	}
+
+	if isBytes {
+		return []byte(text.String()), nil
+	}
+	return text.String(), nil
+}
+
+func (p *literalParser) parseString(out *strings.Builder, raw, isBytes bool) error {
+	start := p.pos
+	quote := p.src[p.pos]
+	delim := string(quote)
+	if strings.HasPrefix(p.src[p.pos:], strings.Repeat(delim, 3)) {
+		delim = strings.Repeat(delim, 3)
+	}
+	p.pos += len(delim)
+
+	for {
+		if p.pos >= len(p.src) {
+			return p.errorAt(start, "unterminated string literal")
+		}
+		if strings.HasPrefix(p.src[p.pos:], delim) {
+			p.pos += len(delim)
+			return nil
+		}
+
+		c := p.src[p.pos]
+		if c == '\n' && len(delim) == 1 {
+			return p.errorAt(start, "unterminated string literal")
+		}
+		if isBytes && c >= utf8.RuneSelf {
+			return p.errorf("bytes can only contain ASCII literal characters")
+		}
+		if c != '\\' {
+			r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+			out.WriteRune(r)
+			p.pos += size
+			continue
+		}
+
+		if p.pos+1 >= len(p.src) {
+			return p.errorAt(start, "unterminated string literal")
+		}
+		if raw {
+			out.WriteByte('\\')
+			out.WriteByte(p.src[p.pos+1])
+			p.pos += 2
+			continue
+		}
+		if err := p.parseEscape(out, isBytes); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *literalParser) parseEscape(out *strings.Builder, isBytes bool) error {
+	escStart := p.pos
+	p.pos++
+	c := p.src[p.pos]
+	p.pos++
+
+	simple := map[byte]byte{
+		'\n': 0, '\\': '\\', '\'': '\'', '"': '"',
+		'a': '\a', 'b': '\b', 'f': '\f', 'n': '\n', 'r': '\r', 't': '\t', 'v': '\v',
+	}
+	if r, ok := simple[c]; ok {
+		if c != '\n' {
+			out.WriteByte(r)
+		}
+		return nil
+	}
+
+	switch {
+	case c >= '0' && c <= '7':
+		end := p.pos
+		for end < len(p.src) && end < escStart+4 && p.src[end] >= '0' && p.src[end] <= '7' {
+			end++
+		}
+		value, _ := strconv.ParseUint(p.src[p.pos-1:end], 8, 32)
+		p.pos = end
+		return p.writeCode(out, escStart, rune(value), isBytes)
+	case c == 'x':
+		return p.parseHexEscape(out, escStart, 2, isBytes)
+	case c == 'u' && !isBytes:
+		return p.parseHexEscape(out, escStart, 4, isBytes)
+	case c == 'U' && !isBytes:
+		return p.parseHexEscape(out, escStart, 8, isBytes)
+	case c == 'N' && !isBytes:
+		return p.errorAt(escStart, "\\N{...} escapes are not supported")
+	}
+
+	out.WriteByte('\\')
+	p.pos--
+	return nil
+}
+
+func (p *literalParser) parseHexEscape(out *strings.Builder, escStart, digits int, isBytes bool) error {
+	if p.pos+digits > len(p.src) {
+		return p.errorAt(escStart, "truncated \\%c escape", p.src[escStart+1])
+	}
+	text := p.src[p.pos : p.pos+digits]
+	for i := 0; i < len(text); i++ {
+		if !isHexDigit(text[i]) {
+			return p.errorAt(escStart, "truncated \\%c escape", p.src[escStart+1])
+		}
+	}
+	value, _ := strconv.ParseUint(text, 16, 32)
+	p.pos += digits
+	return p.writeCode(out, escStart, rune(value), isBytes)
+}
+
+func (p *literalParser) writeCode(out *strings.Builder, escStart int, r rune, isBytes bool) error {
+	if isBytes {
+		if r > 0xff {
+			return p.errorAt(escStart, "octal escape value out of range")
+		}
+		out.WriteByte(byte(r))
+		return nil
+	}
+	if r > utf8.MaxRune {
+		return p.errorAt(escStart, "illegal Unicode character")
+	}
+	out.WriteRune(r)
+	return nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
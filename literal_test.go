@@ -0,0 +1,220 @@
+package ezarr
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// Test | ParseLiteral verifies scalar literals against ast.literal_eval results
+func TestParseLiteralScalars(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	negHuge := new(big.Int).Neg(huge)
+
+	cases := []struct {
+		src      string
+		expected interface{}
+	}{
+		{"None", nil},
+		{"True", true},
+		{"False", false},
+		{"42", 42},
+		{"-42", -42},
+		{"- 5", -5},
+		{"-(1)", -1},
+		{"1_000", 1000},
+		{"0x_ff", 255},
+		{"0xa_b", 171},
+		{"0o17", 15},
+		{"0b101", 5},
+		{"00", 0},
+		{"123456789012345678901234567890", huge},
+		{"-123456789012345678901234567890", negHuge},
+		{"1.", 1.0},
+		{".5", 0.5},
+		{"1e3", 1000.0},
+		{"1_0.5e-1_0", 1.05e-09},
+		{"3j", 3i},
+		{"1+2j", 1 + 2i},
+		{"-1-2j", -1 - 2i},
+		{"'a' 'b'", "ab"},
+		{`"it's"`, "it's"},
+		{`'\'\"\\\a\b\f\n\r\t\v'`, "'\"\\\a\b\f\n\r\t\v"},
+		{`'\x41\101é\U0001F600'`, "AAé\U0001F600"},
+		{`'\q'`, `\q`},
+		{`r'\n'`, `\n`},
+		{`R"\d+"`, `\d+`},
+		{`u'text'`, "text"},
+		{`'''multi
+line'''`, "multi\nline"},
+		{`'line \
+continued'`, "line continued"},
+		{`b'\x00\xffa'`, []byte{0, 0xff, 'a'}},
+		{`rb'\x00'`, []byte(`\x00`)},
+		{`b'A'`, []byte(`A`)},
+	}
+
+	for _, c := range cases {
+		got, err := ParseLiteral(c.src)
+		if err != nil {
+			t.Errorf("ParseLiteral(%s) returned error: %v", c.src, err)
+			continue
+		}
+		if !Equal(got, c.expected) {
+			t.Errorf("ParseLiteral(%s) = %#v, expected %#v", c.src, got, c.expected)
+		}
+	}
+}
+
+// Test | ParseLiteral verifies nested containers and tuples
+func TestParseLiteralContainers(t *testing.T) {
+	got, err := ParseLiteral("{'a': [1, 2.5, None, True], 'b': (3, 4)}")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	dict, ok := got.(*Dict)
+	if !ok {
+		t.Fatalf("Expected *Dict, got %T", got)
+	}
+	a, _ := dict.Get("a")
+	if !Equal(a, New(1, 2.5, nil, true)) {
+		t.Errorf("Expected [1, 2.5, None, True] for 'a', got %v", a)
+	}
+	b, _ := dict.Get("b")
+	if !Equal(b, NewTuple(3, 4)) {
+		t.Errorf("Expected (3, 4) for 'b', got %v", b)
+	}
+
+	cases := []struct {
+		src      string
+		expected interface{}
+	}{
+		{"[]", New()},
+		{"[1, [2, [3]],]", New(1, New(2, New(3)))},
+		{"{}", &Dict{Keys: []interface{}{}, Values: []interface{}{}}},
+		{"()", NewTuple()},
+		{"(1)", 1},
+		{"(1,)", NewTuple(1)},
+		{"1, 2", NewTuple(1, 2)},
+		{"1,", NewTuple(1)},
+		{"[\n  1,  # first\n  2\n]", New(1, 2)},
+		{"{1, 'a', 1}", NewSet(1, "a")},
+		{"set()", &Set{}},
+	}
+
+	for _, c := range cases {
+		got, err := ParseLiteral(c.src)
+		if err != nil {
+			t.Errorf("ParseLiteral(%q) returned error: %v", c.src, err)
+			continue
+		}
+		if !Equal(got, c.expected) {
+			t.Errorf("ParseLiteral(%q) = %v, expected %v", c.src, Repr(got), Repr(c.expected))
+		}
+	}
+
+	dups, _ := ParseLiteral("{'a': 1, 'a': 2}")
+	if dups.(*Dict).Len() != 1 || dups.(*Dict).GetDefault("a", nil) != 2 {
+		t.Errorf("Expected later duplicate keys to win, got %v", dups)
+	}
+}
+
+// Test | ParseLiteral verifies that malformed input reports a positioned SyntaxError
+func TestParseLiteralErrors(t *testing.T) {
+	cases := []string{
+		"",
+		"[1, 2",
+		"{'a' 1}",
+		"'unterminated",
+		"'a\nb'",
+		"1 + 2",
+		"+True",
+		"x",
+		"f'{x}'",
+		"1__0",
+		"1_",
+		"1_e5",
+		"1e_5",
+		"1_.5",
+		"1._5",
+		"0x",
+		"012",
+		"'a' b'b'",
+		"b'é'",
+		"'\\x4'",
+		"[1] [2]",
+	}
+
+	for _, src := range cases {
+		_, err := ParseLiteral(src)
+		if !errors.Is(err, ErrSyntax) {
+			t.Errorf("Expected SyntaxError for %q, got %v", src, err)
+		}
+	}
+
+	_, err := ParseLiteral("'a\\N{EM DASH}b'")
+	if !errors.Is(err, ErrSyntax) || !strings.Contains(err.Error(), "\\N{...} escapes are not supported") {
+		t.Errorf("Expected SyntaxError for the unsupported \\N escape, got %v", err)
+	}
+
+	_, err = ParseLiteral("[1,\n 2,\n ?]")
+	var syntaxErr *SyntaxError
+	if !errors.As(err, &syntaxErr) || syntaxErr.Line != 3 || syntaxErr.Column != 2 {
+		t.Errorf("Expected error at line 3, column 2, got %v", err)
+	}
+}
+
+// Test | ParseLiteral verifies unhashable set elements and dict keys and excessive nesting
+func TestParseLiteralInvalidValues(t *testing.T) {
+	for _, src := range []string{"{[1]: 2}", "{[1]}", "{1, {}}", "{(1, [2]): 3}", "{1: 2, {3}: 4}"} {
+		if _, err := ParseLiteral(src); !errors.Is(err, ErrType) {
+			t.Errorf("Expected TypeError for %q, got %v", src, err)
+		}
+	}
+	if v, err := ParseLiteral("{(1, 'a'): [2], 3: {}}"); err != nil || v.(*Dict).Len() != 2 {
+		t.Errorf("Expected hashable keys with unhashable values to parse, got %v, error: %v", v, err)
+	}
+
+	nested := strings.Repeat("[", 150) + strings.Repeat("]", 150)
+	if _, err := ParseLiteral(nested); err != nil {
+		t.Errorf("Expected 150 nested lists to parse, got %v", err)
+	}
+	for _, src := range []string{
+		strings.Repeat("[", 100000) + strings.Repeat("]", 100000),
+		strings.Repeat("(", 100000),
+		strings.Repeat("-", 100000) + "1",
+	} {
+		if _, err := ParseLiteral(src); !errors.Is(err, ErrValue) {
+			t.Errorf("Expected ValueError for input nested too deeply, got %v", err)
+		}
+	}
+}
+
+// Test | ParseLiteral verifies that parsing the repr of a value gives back an equal value
+func TestParseLiteralRoundTrip(t *testing.T) {
+	huge, _ := new(big.Int).SetString("-98765432109876543210", 10)
+	inner, _ := NewDict("x", 1.5, 2, New("y", nil))
+	values := []interface{}{
+		New(1, -2, 0.1, 1e16, 1e-05, -0.5, "it's", `say "hi"`, "tab\t\n\x00é\U0001F600​"),
+		New(true, false, nil, []byte("b'\"\xff"), 1+2i, -3i, huge),
+		inner,
+		New(inner, New(New(), &Dict{})),
+		New(NewSet(1, "a"), &Set{}),
+		inner.GetItemTuples(),
+		NewTuple(NewTuple(), NewTuple(1), NewTuple("a", 2.5)),
+	}
+
+	for _, v := range values {
+		src := Repr(v)
+		got, err := ParseLiteral(src)
+		if err != nil {
+			t.Errorf("ParseLiteral(%s) returned error: %v", src, err)
+			continue
+		}
+		if !Equal(got, v) {
+			t.Errorf("Round trip of %s gave %s", src, Repr(got))
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package ezarr
+
+import "iter"
+
+// Query is a lazy pipeline over a sequence, the Go spelling of a Python
+// comprehension:
+//
+//	[f(x) for x in xs if p(x)]  ->  From(xs).Where(p).Select(f).ToList()
+//
+// Each method returns a new Query and nothing is evaluated until the Query
+// is consumed by ToList, ToDict, ToSet or Values. A Query can be consumed
+// more than once; each time it ranges over its source again.
+type Query struct {
+	run func(yield func(interface{}) bool) error
+}
+
+func From(l *List) *Query {
+	return FromSeq(l.Values())
+}
+
+// FromDict queries the items of d as (key, value) Tuples, like d.items().
+func FromDict(d *Dict) *Query {
+	return FromSeq(d.Items())
+}
+
+func FromSeq(seq iter.Seq[interface{}]) *Query {
+	return &Query{run: func(yield func(interface{}) bool) error {
+		for v := range seq {
+			if !yield(v) {
+				break
+			}
+		}
+		return nil
+	}}
+}
+
+// Where keeps the elements for which predicate is true. A nil predicate
+// tests the elements themselves with Truth.
+func (q *Query) Where(predicate func(interface{}) bool) *Query {
+	if predicate == nil {
+		predicate = Truth
+	}
+	return &Query{run: func(yield func(interface{}) bool) error {
+		return q.run(func(v interface{}) bool {
+			return !predicate(v) || yield(v)
+		})
+	}}
+}
+
+func (q *Query) Select(f func(interface{}) interface{}) *Query {
+	return &Query{run: func(yield func(interface{}) bool) error {
+		return q.run(func(v interface{}) bool {
+			return yield(f(v))
+		})
+	}}
+}
+
+// SelectMany replaces each element with the elements of f(element), like
+// the second for clause of a nested comprehension:
+//
+//	[y for x in xs for y in x]  ->  From(xs).SelectMany(f).ToList()
+func (q *Query) SelectMany(f func(interface{}) iter.Seq[interface{}]) *Query {
+	return &Query{run: func(yield func(interface{}) bool) error {
+		return q.run(func(v interface{}) bool {
+			for inner := range f(v) {
+				if !yield(inner) {
+					return false
+				}
+			}
+			return true
+		})
+	}}
+}
+
+// Distinct drops elements Equal to an earlier one, keeping the first.
+func (q *Query) Distinct() *Query {
+	return &Query{run: func(yield func(interface{}) bool) error {
+		seen := &Set{}
+		return q.run(func(v interface{}) bool {
+			if seen.Contains(v) {
+				return true
+			}
+			seen.Add(v)
+			return yield(v)
+		})
+	}}
+}
+
+// OrderBy sorts the elements by key like List.SortBy, keeping the order of
+// equal elements. It reads every element of the source before yielding the
+// first one. Elements that cannot be compared make the Query fail with a
+// TypeError.
+func (q *Query) OrderBy(key func(interface{}) interface{}) *Query {
+	return q.orderBy(key, false)
+}
+
+func (q *Query) OrderByDescending(key func(interface{}) interface{}) *Query {
+	return q.orderBy(key, true)
+}
+
+func (q *Query) orderBy(key func(interface{}) interface{}, reverse bool) *Query {
+	return &Query{run: func(yield func(interface{}) bool) error {
+		sorted, err := q.ToList()
+		if err != nil {
+			return err
+		}
+		if err := sorted.SortBy(key, reverse); err != nil {
+			return err
+		}
+		for _, v := range sorted.Elements {
+			if !yield(v) {
+				break
+			}
+		}
+		return nil
+	}}
+}
+
+// Values yields the results of q, each with a nil error. When q fails it
+// ends with a nil value and the error that ToList would return.
+func (q *Query) Values() iter.Seq2[interface{}, error] {
+	return func(yield func(interface{}, error) bool) {
+		stopped := false
+		err := q.run(func(v interface{}) bool {
+			stopped = !yield(v, nil)
+			return !stopped
+		})
+		if err != nil && !stopped {
+			yield(nil, err)
+		}
+	}
+}
+
+func (q *Query) ToList() (*List, error) {
+	result := New()
+	err := q.run(func(v interface{}) bool {
+		result.Append(v)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ToDict builds a Dict from keyFunc(element): valueFunc(element), like a
+// dict comprehension; a later element replaces the value of an earlier one
+// with an Equal key. A nil keyFunc or valueFunc uses the element itself.
+func (q *Query) ToDict(keyFunc, valueFunc func(interface{}) interface{}) (*Dict, error) {
+	identity := func(v interface{}) interface{} { return v }
+	if keyFunc == nil {
+		keyFunc = identity
+	}
+	if valueFunc == nil {
+		valueFunc = identity
+	}
+	result := &Dict{Keys: []interface{}{}, Values: []interface{}{}}
+	err := q.run(func(v interface{}) bool {
+		result.Set(keyFunc(v), valueFunc(v))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Query) ToSet() (*Set, error) {
+	result := &Set{}
+	err := q.run(func(v interface{}) bool {
+		result.Add(v)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,132 @@
+package ezarr
+
+import (
+	"errors"
+	"iter"
+	"testing"
+)
+
+// Test | Query verifies Where, Select, SelectMany, Distinct and OrderBy against Python comprehensions
+func TestQuery(t *testing.T) {
+	xs := New(5, 3, 8, 3, 1, 8)
+	odd := func(v interface{}) bool { return v.(int)%2 == 1 }
+	square := func(v interface{}) interface{} { return v.(int) * v.(int) }
+
+	// [x * x for x in xs if x % 2 == 1]
+	got, err := From(xs).Where(odd).Select(square).ToList()
+	if err != nil || !Equal(got, New(25, 9, 9, 1)) {
+		t.Errorf("Expected [25, 9, 9, 1], got %v, error: %v", got, err)
+	}
+
+	// list(dict.fromkeys(xs))
+	got, _ = From(xs).Distinct().ToList()
+	if !Equal(got, New(5, 3, 8, 1)) {
+		t.Errorf("Expected [5, 3, 8, 1], got %v", got)
+	}
+	got, _ = From(xs).Distinct().OrderBy(nil).ToList()
+	if !Equal(got, New(1, 3, 5, 8)) {
+		t.Errorf("Expected [1, 3, 5, 8], got %v", got)
+	}
+	got, _ = From(xs).OrderByDescending(nil).ToList()
+	if !Equal(got, New(8, 8, 5, 3, 3, 1)) {
+		t.Errorf("Expected [8, 8, 5, 3, 3, 1], got %v", got)
+	}
+
+	// [y for x in rows for y in x]
+	rows := New(New(1, 2), New(), New(3))
+	flatten := func(v interface{}) iter.Seq[interface{}] { return v.(*List).Values() }
+	got, _ = From(rows).SelectMany(flatten).ToList()
+	if !Equal(got, New(1, 2, 3)) {
+		t.Errorf("Expected [1, 2, 3], got %v", got)
+	}
+
+	words := New("bb", "a", "cc", "d")
+	length := func(v interface{}) interface{} { return len(v.(string)) }
+	got, _ = From(words).OrderBy(length).ToList()
+	if !Equal(got, New("a", "d", "bb", "cc")) {
+		t.Errorf("Expected a stable sort, got %v", got)
+	}
+	if _, err := From(New(1, "a")).OrderBy(nil).ToList(); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError for incomparable elements, got %v", err)
+	}
+	if got, _ := From(New(0, 1, "", "a")).Where(nil).ToList(); !Equal(got, New(1, "a")) {
+		t.Errorf("Expected a nil predicate to keep true elements, got %v", got)
+	}
+}
+
+// Test | Query verifies lazy evaluation and reuse
+func TestQueryLazy(t *testing.T) {
+	calls := 0
+	q := FromSeq(RangeTo(1000).ToList().Values()).Select(func(v interface{}) interface{} {
+		calls++
+		return v
+	})
+	if calls != 0 {
+		t.Errorf("Expected no calls before the query runs, got %d", calls)
+	}
+	for v, err := range q.Values() {
+		if err != nil || v == 2 {
+			break
+		}
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+
+	l := New(1, 2)
+	q = From(l).Distinct()
+	l.Append(1).Append(3)
+	if got, _ := q.ToList(); !Equal(got, New(1, 2, 3)) {
+		t.Errorf("Expected the query to see appended elements, got %v", got)
+	}
+	if got, _ := q.ToList(); !Equal(got, New(1, 2, 3)) {
+		t.Errorf("Expected the query to run again, got %v", got)
+	}
+
+	var err error
+	for v, e := range From(New(1, "a")).OrderBy(nil).Values() {
+		if v != nil || e == nil {
+			t.Errorf("Expected only an error from a failing query, got %v, error: %v", v, e)
+		}
+		err = e
+	}
+	if !errors.Is(err, ErrType) {
+		t.Errorf("Expected a TypeError, got %v", err)
+	}
+}
+
+// Test | Query verifies ToDict and ToSet, including Dict items as a source
+func TestQueryToDictToSet(t *testing.T) {
+	prices, _ := NewDict("apple", 3, "pear", 5, "plum", 2)
+	key := func(v interface{}) interface{} { k, _ := v.(Tuple).Get(0); return k }
+	value := func(v interface{}) interface{} { x, _ := v.(Tuple).Get(1); return x }
+
+	// {k: v for k, v in prices.items() if v > 2}
+	expensive, err := FromDict(prices).Where(func(v interface{}) bool {
+		return value(v).(int) > 2
+	}).ToDict(key, value)
+	if err != nil || Repr(expensive) != "{'apple': 3, 'pear': 5}" {
+		t.Errorf("Expected {'apple': 3, 'pear': 5}, got %s, error: %v", Repr(expensive), err)
+	}
+
+	// {len(w): w for w in words}
+	byLength, _ := From(New("a", "bb", "c")).ToDict(func(v interface{}) interface{} {
+		return len(v.(string))
+	}, nil)
+	if Repr(byLength) != "{1: 'c', 2: 'bb'}" {
+		t.Errorf("Expected later values to win, got %s", Repr(byLength))
+	}
+
+	s, err := From(New(1, 2, 1, 3)).Select(func(v interface{}) interface{} {
+		return v.(int) % 2
+	}).ToSet()
+	if err != nil || !s.Equal(NewSet(1, 0)) {
+		t.Errorf("Expected {1, 0}, got %v, error: %v", s, err)
+	}
+	if _, err := From(New(1, "a")).OrderBy(nil).ToSet(); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError from ToSet, got %v", err)
+	}
+	if d, err := From(New()).ToDict(nil, nil); err != nil || d.Len() != 0 {
+		t.Errorf("Expected an empty Dict, got %v, error: %v", d, err)
+	}
+}
@@ -0,0 +1,182 @@
+package ezarr
+
+import (
+	"fmt"
+	"math"
+)
+
+// Range is an immutable sequence of integers, like Python's range. It only
+// stores its bounds, so its length, membership tests and indexing are O(1)
+// and its elements are produced on demand.
+type Range struct {
+	start, stop, step int
+	length            int
+}
+
+// NewRange returns the integers from start up to, but not including, stop,
+// counting by step. A negative step counts down. It returns a ValueError
+// when step is zero or the range holds more than math.MaxInt integers.
+func NewRange(start, stop, step int) (Range, error) {
+	if step == 0 {
+		return Range{}, &ValueError{Value: step, Msg: "range step cannot be zero"}
+	}
+
+	var n uint64
+	switch {
+	case step > 0 && start < stop:
+		n = (uint64(stop)-uint64(start)-1)/uint64(step) + 1
+	case step < 0 && start > stop:
+		n = (uint64(start)-uint64(stop)-1)/absStep(step) + 1
+	}
+	if n > math.MaxInt {
+		return Range{}, &ValueError{Value: n, Msg: "range has too many elements"}
+	}
+	return Range{start: start, stop: stop, step: step, length: int(n)}, nil
+}
+
+// RangeTo returns the integers from 0 up to, but not including, stop.
+func RangeTo(stop int) Range {
+	r, _ := NewRange(0, stop, 1)
+	return r
+}
+
+func (r Range) Start() int {
+	return r.start
+}
+
+func (r Range) Stop() int {
+	return r.stop
+}
+
+func (r Range) Step() int {
+	return r.step
+}
+
+func (r Range) Len() int {
+	return r.length
+}
+
+func (r Range) Get(index int) (int, error) {
+	position := index
+	if position < 0 {
+		position = r.length + position
+	}
+	if position < 0 || position >= r.length {
+		return 0, indexOutOfRange(index)
+	}
+	return r.start + position*r.step, nil
+}
+
+func (r Range) Contains(x int) bool {
+	_, ok := r.position(x)
+	return ok
+}
+
+// Index returns the position of x, or a ValueError when x is not in r.
+func (r Range) Index(x int) (int, error) {
+	if i, ok := r.position(x); ok {
+		return i, nil
+	}
+	return -1, &ValueError{Value: x, Msg: fmt.Sprintf("%d is not in range", x)}
+}
+
+func (r Range) Count(x int) int {
+	if r.Contains(x) {
+		return 1
+	}
+	return 0
+}
+
+// Slice returns the elements from start up to end as a Range, with the
+// bounds handled like List.Slice.
+func (r Range) Slice(start, end int) Range {
+	start, end = clampIndex(r.length, start), clampIndex(r.length, end)
+	return Range{start: r.start + start*r.step, stop: r.start + end*r.step, step: r.step, length: max(end-start, 0)}
+}
+
+// SliceStep slices r like Python's r[start:stop:step]. Omit leaves a bound
+// out, and the result is again a Range.
+func (r Range) SliceStep(start, stop, step int) (Range, error) {
+	start, stop, step, n, err := sliceIndices(r.length, start, stop, step)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{start: r.start + start*r.step, stop: r.start + stop*r.step, step: r.step * step, length: n}, nil
+}
+
+// Reversed returns the elements of r in reverse order as a Range.
+func (r Range) Reversed() Range {
+	last := r.start + (r.length-1)*r.step
+	if r.length == 0 {
+		last = r.start
+	}
+	return Range{start: last, stop: last - r.length*r.step, step: -r.step, length: r.length}
+}
+
+func (r Range) ToList() *List {
+	elements := make([]interface{}, r.length)
+	v := r.start
+	for i := range elements {
+		elements[i] = v
+		v += r.step
+	}
+	return &List{Elements: elements}
+}
+
+func (r Range) String() string {
+	return r.Repr()
+}
+
+func (r Range) Repr() string {
+	if r.step == 1 {
+		return fmt.Sprintf("range(%d, %d)", r.start, r.stop)
+	}
+	return fmt.Sprintf("range(%d, %d, %d)", r.start, r.stop, r.step)
+}
+
+// hashElements returns what makes two ranges equal, as in Python: their
+// length, their start unless they are empty, and their step when they have
+// more than one element.
+func (r Range) hashElements() ([]interface{}, bool) {
+	id := []interface{}{r.length, 0, 0}
+	if r.length > 0 {
+		id[1] = r.start
+	}
+	if r.length > 1 {
+		id[2] = r.step
+	}
+	return id, true
+}
+
+// position returns the index of x in r, if x is one of its elements. The
+// distance to start is computed without overflow.
+func (r Range) position(x int) (int, bool) {
+	if r.length == 0 {
+		return 0, false
+	}
+	var distance uint64
+	if r.step > 0 {
+		if x < r.start || x >= r.stop {
+			return 0, false
+		}
+		distance = uint64(x) - uint64(r.start)
+	} else {
+		if x > r.start || x <= r.stop {
+			return 0, false
+		}
+		distance = uint64(r.start) - uint64(x)
+	}
+	step := absStep(r.step)
+	if distance%step != 0 {
+		return 0, false
+	}
+	return int(distance / step), true
+}
+
+// absStep returns |step|, which does not fit in an int for math.MinInt.
+func absStep(step int) uint64 {
+	if step < 0 {
+		return uint64(-(step + 1)) + 1
+	}
+	return uint64(step)
+}
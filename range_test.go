@@ -0,0 +1,137 @@
+package ezarr
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// Test | NewRange verifies lengths and elements against Python results
+func TestRange(t *testing.T) {
+	cases := []struct {
+		start, stop, step int
+		expected          *List
+		repr              string
+	}{
+		{0, 5, 1, New(0, 1, 2, 3, 4), "range(0, 5)"},
+		{1, 10, 3, New(1, 4, 7), "range(1, 10, 3)"},
+		{10, 0, -3, New(10, 7, 4, 1), "range(10, 0, -3)"},
+		{5, 5, 1, New(), "range(5, 5)"},
+		{0, 5, -1, New(), "range(0, 5, -1)"},
+	}
+	for _, c := range cases {
+		r, err := NewRange(c.start, c.stop, c.step)
+		if err != nil {
+			t.Fatalf("NewRange returned error: %v", err)
+		}
+		if !Equal(r.ToList(), c.expected) || r.Len() != c.expected.Len() || Repr(r) != c.repr {
+			t.Errorf("%s: expected %v, got %v with length %d", c.repr, c.expected, r.ToList(), r.Len())
+		}
+	}
+
+	if _, err := NewRange(0, 1, 0); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError for a zero step, got %v", err)
+	}
+	if _, err := NewRange(math.MinInt, math.MaxInt, 1); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError for a range that is too long, got %v", err)
+	}
+	if r, _ := NewRange(math.MinInt, math.MaxInt, 3); uint64(r.Len()) != (math.MaxUint64-1)/3+1 {
+		t.Errorf("Expected the length not to overflow, got %d", r.Len())
+	}
+}
+
+// Test | Range verifies O(1) Get, Contains, Index and Count
+func TestRangeLookup(t *testing.T) {
+	r, _ := NewRange(10, -10, -4)
+
+	if v, err := r.Get(-1); err != nil || v != -6 {
+		t.Errorf("Expected -6, got %v, error: %v", v, err)
+	}
+	if _, err := r.Get(5); !errors.Is(err, ErrIndex) {
+		t.Errorf("Expected IndexError, got %v", err)
+	}
+	if !r.Contains(2) || r.Contains(3) || r.Contains(-10) || r.Contains(14) {
+		t.Error("Unexpected Contains result")
+	}
+	if i, err := r.Index(-2); err != nil || i != 3 {
+		t.Errorf("Expected index 3, got %d, error: %v", i, err)
+	}
+	if _, err := r.Index(0); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError, got %v", err)
+	}
+	if r.Count(6) != 1 || r.Count(5) != 0 {
+		t.Error("Unexpected Count result")
+	}
+
+	huge := RangeTo(math.MaxInt)
+	if !huge.Contains(math.MaxInt-1) || huge.Contains(math.MaxInt) {
+		t.Error("Expected Contains to work at the limits of int")
+	}
+}
+
+// Test | Range verifies slicing and reversing against Python results
+func TestRangeSlice(t *testing.T) {
+	r := RangeTo(10)
+
+	if got := r.Slice(2, 8); Repr(got) != "range(2, 8)" {
+		t.Errorf("Expected range(2, 8), got %s", Repr(got))
+	}
+	if got := r.Slice(-3, 100); Repr(got) != "range(7, 10)" {
+		t.Errorf("Expected range(7, 10), got %s", Repr(got))
+	}
+	if got := r.Slice(5, 2); Repr(got) != "range(5, 2)" || got.Len() != 0 {
+		t.Errorf("Expected range(5, 2), got %s", Repr(got))
+	}
+	if got := r.Slice(-2, 3); Repr(got) != "range(8, 3)" || got.Len() != 0 {
+		t.Errorf("Expected range(8, 3), got %s", Repr(got))
+	}
+	stepped, _ := NewRange(0, 20, 3)
+	if got := stepped.Slice(4, 1); Repr(got) != "range(12, 3, 3)" || !Equal(got, r.Slice(5, 5)) {
+		t.Errorf("Expected an empty range(12, 3, 3), got %s", Repr(got))
+	}
+
+	cases := []struct {
+		start, stop, step int
+		repr              string
+	}{
+		{2, 8, 2, "range(2, 8, 2)"},
+		{Omit, Omit, -1, "range(9, -1, -1)"},
+		{Omit, Omit, 3, "range(0, 10, 3)"},
+		{8, 2, -3, "range(8, 2, -3)"},
+	}
+	for _, c := range cases {
+		got, err := r.SliceStep(c.start, c.stop, c.step)
+		if err != nil || Repr(got) != c.repr {
+			t.Errorf("Expected %s, got %s, error: %v", c.repr, Repr(got), err)
+		}
+	}
+	if _, err := r.SliceStep(Omit, Omit, 0); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError for a zero step, got %v", err)
+	}
+
+	odd, _ := NewRange(1, 10, 2)
+	if got := odd.Reversed(); Repr(got) != "range(9, -1, -2)" || !Equal(got.ToList(), New(9, 7, 5, 3, 1)) {
+		t.Errorf("Expected range(9, -1, -2), got %s", Repr(got))
+	}
+}
+
+// Test | Range verifies Python equality and lazy iteration
+func TestRangeEqualIter(t *testing.T) {
+	a, _ := NewRange(0, 3, 2)
+	b, _ := NewRange(0, 4, 2)
+	c, _ := NewRange(5, 5, 3)
+	if !Equal(a, b) || Hash(a) != Hash(b) || !Equal(c, RangeTo(0)) || Equal(a, RangeTo(2)) {
+		t.Error("Expected ranges with the same elements to be equal")
+	}
+
+	var seen []int
+	for v := range RangeTo(math.MaxInt).Values() {
+		seen = append(seen, v)
+		if len(seen) == 3 {
+			break
+		}
+	}
+	if len(seen) != 3 || seen[2] != 2 {
+		t.Errorf("Expected iteration to stop after 3 elements, got %v", seen)
+	}
+}
@@ -0,0 +1,449 @@
+package ezarr
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Reprer lets a type control how Repr renders it.
+type Reprer interface {
+	Repr() string
+}
+
+var reprStrings atomic.Bool
+
+// EnableRepr switches the String methods of the containers in this package
+// between the default Go-style output and Python repr output.
+func EnableRepr(enabled bool) {
+	reprStrings.Store(enabled)
+}
+
+// Repr renders v the way Python's repr would render the equivalent value.
+// Containers that contain themselves are rendered as [...] or {...}.
+func Repr(v interface{}) string {
+	var b strings.Builder
+	writeRepr(&b, reflect.ValueOf(v), map[visit]bool{})
+	return b.String()
+}
+
+func (l *List) Repr() string {
+	return Repr(l)
+}
+
+func (d *Dict) Repr() string {
+	return Repr(d)
+}
+
+type listConverter interface {
+	ToList() *List
+}
+
+type dictConverter interface {
+	ToDict() *Dict
+}
+
+func writeRepr(b *strings.Builder, v reflect.Value, active map[visit]bool) {
+	if !v.IsValid() {
+		b.WriteString("None")
+		return
+	}
+
+	if v.CanInterface() {
+		switch x := v.Interface().(type) {
+		case *List:
+			if x == nil {
+				b.WriteString("None")
+				return
+			}
+			writeSequence(b, v, "[", "]", "[...]", x.Elements, active)
+			return
+		case *Dict:
+			if x == nil {
+				b.WriteString("None")
+				return
+			}
+			writeMapping(b, v, x.Keys, x.Values, active)
+			return
+		case *Set:
+			if x == nil {
+				b.WriteString("None")
+				return
+			}
+			writeSet(b, v, x, active)
+			return
+		case *FrozenSet:
+			if x == nil {
+				b.WriteString("None")
+				return
+			}
+			if x.Len() == 0 {
+				b.WriteString("frozenset()")
+				return
+			}
+			b.WriteString("frozenset(")
+			writeSet(b, v, &x.set, active)
+			b.WriteString(")")
+			return
+		case *DefaultDict:
+			if x == nil {
+				b.WriteString("None")
+				return
+			}
+			if x.Factory == nil {
+				b.WriteString("defaultdict(None, ")
+			} else {
+				b.WriteString("defaultdict(<function>, ")
+			}
+			writeMapping(b, v, x.Keys, x.Values, active)
+			b.WriteString(")")
+			return
+		case *Counter:
+			if x == nil {
+				b.WriteString("None")
+				return
+			}
+			if x.Len() == 0 {
+				b.WriteString("Counter()")
+				return
+			}
+			d := x.mostCommonDict()
+			b.WriteString("Counter(")
+			writeMapping(b, v, d.Keys, d.Values, active)
+			b.WriteString(")")
+			return
+		case *Deque:
+			if x == nil {
+				b.WriteString("None")
+				return
+			}
+			b.WriteString("deque(")
+			writeSequence(b, v, "[", "]", "[...]", x.elements(), active)
+			if x.bounded {
+				fmt.Fprintf(b, ", maxlen=%d", x.maxlen)
+			}
+			b.WriteString(")")
+			return
+		case *SortedList:
+			if x == nil {
+				b.WriteString("None")
+				return
+			}
+			b.WriteString("SortedList(")
+			writeSequence(b, v, "[", "]", "[...]", x.elements(), active)
+			b.WriteString(")")
+			return
+		case *SortedDict:
+			if x == nil {
+				b.WriteString("None")
+				return
+			}
+			b.WriteString("SortedDict(")
+			writeMapping(b, v, x.GetKeys().Elements, x.GetValues().Elements, active)
+			b.WriteString(")")
+			return
+		case Tuple:
+			writeTuple(b, x, active)
+			return
+		case *big.Int:
+			b.WriteString(x.String())
+			return
+		case Reprer:
+			if v.Kind() != reflect.Pointer || !v.IsNil() {
+				b.WriteString(x.Repr())
+				return
+			}
+		case listConverter:
+			if v.Kind() != reflect.Pointer || !v.IsNil() {
+				writeRepr(b, reflect.ValueOf(x.ToList()), active)
+				return
+			}
+		case dictConverter:
+			if v.Kind() != reflect.Pointer || !v.IsNil() {
+				writeRepr(b, reflect.ValueOf(x.ToDict()), active)
+				return
+			}
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			b.WriteString("True")
+		} else {
+			b.WriteString("False")
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		b.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32:
+		b.WriteString(reprFloat(v.Float(), 32))
+	case reflect.Float64:
+		b.WriteString(reprFloat(v.Float(), 64))
+	case reflect.Complex64, reflect.Complex128:
+		b.WriteString(reprComplex(v.Complex()))
+	case reflect.String:
+		b.WriteString(reprString(v.String()))
+	case reflect.Interface, reflect.Pointer:
+		if v.IsNil() {
+			b.WriteString("None")
+			return
+		}
+		writeRepr(b, v.Elem(), active)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b.WriteString(reprBytes(v.Bytes()))
+			return
+		}
+		if v.IsNil() {
+			b.WriteString("[]")
+			return
+		}
+		writeSequence(b, v, "[", "]", "[...]", sequenceElements(v), active)
+	case reflect.Array:
+		writeSequence(b, v, "[", "]", "[...]", sequenceElements(v), active)
+	case reflect.Map:
+		keys := v.MapKeys()
+		sortReprKeys(keys)
+		mapKeys := make([]interface{}, len(keys))
+		mapValues := make([]interface{}, len(keys))
+		for i, k := range keys {
+			mapKeys[i] = k.Interface()
+			mapValues[i] = v.MapIndex(k).Interface()
+		}
+		writeMapping(b, v, mapKeys, mapValues, active)
+	default:
+		if v.CanInterface() {
+			fmt.Fprintf(b, "%v", v.Interface())
+		} else {
+			b.WriteString(v.String())
+		}
+	}
+}
+
+// enter marks a container as being rendered and reports whether it
+// already was, which means it contains itself.
+func enter(v reflect.Value, active map[visit]bool) (visit, bool) {
+	key := visit{typ: v.Type()}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		key.a1 = v.UnsafePointer()
+		if v.Kind() == reflect.Slice {
+			key.n = v.Len()
+		}
+	default:
+		return key, false
+	}
+	if active[key] {
+		return key, true
+	}
+	active[key] = true
+	return key, false
+}
+
+func writeSequence(b *strings.Builder, v reflect.Value, open, close, cycle string, elements []interface{}, active map[visit]bool) {
+	key, cyclic := enter(v, active)
+	if cyclic {
+		b.WriteString(cycle)
+		return
+	}
+	defer delete(active, key)
+
+	b.WriteString(open)
+	for i, e := range elements {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		writeRepr(b, reflect.ValueOf(e), active)
+	}
+	b.WriteString(close)
+}
+
+func writeTuple(b *strings.Builder, t Tuple, active map[visit]bool) {
+	if t.typ != nil {
+		b.WriteString(t.typ.name)
+	}
+	b.WriteString("(")
+	for i, e := range t.elements {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		if t.typ != nil {
+			b.WriteString(t.typ.fields[i])
+			b.WriteString("=")
+		}
+		writeRepr(b, reflect.ValueOf(e), active)
+	}
+	if len(t.elements) == 1 && t.typ == nil {
+		b.WriteString(",")
+	}
+	b.WriteString(")")
+}
+
+func writeSet(b *strings.Builder, v reflect.Value, s *Set, active map[visit]bool) {
+	if s.Len() == 0 {
+		b.WriteString("set()")
+		return
+	}
+	writeSequence(b, v, "{", "}", "{...}", s.items.Keys, active)
+}
+
+func writeMapping(b *strings.Builder, v reflect.Value, keys, values []interface{}, active map[visit]bool) {
+	key, cyclic := enter(v, active)
+	if cyclic {
+		b.WriteString("{...}")
+		return
+	}
+	defer delete(active, key)
+
+	b.WriteString("{")
+	for i := range keys {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		writeRepr(b, reflect.ValueOf(keys[i]), active)
+		b.WriteString(": ")
+		writeRepr(b, reflect.ValueOf(values[i]), active)
+	}
+	b.WriteString("}")
+}
+
+func sortReprKeys(keys []reflect.Value) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		a, b := keys[i].Interface(), keys[j].Interface()
+		if c, err := Compare(a, b); err == nil {
+			return c < 0
+		}
+		return Repr(a) < Repr(b)
+	})
+}
+
+// reprFloat formats f like Python's float repr: the shortest string that
+// round-trips, in positional notation for exponents from -4 to 15 and in
+// scientific notation otherwise.
+func reprFloat(f float64, bitSize int) string {
+	switch {
+	case math.IsNaN(f):
+		return "nan"
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+
+	s := strconv.FormatFloat(f, 'e', -1, bitSize)
+	exp, _ := strconv.Atoi(s[strings.IndexByte(s, 'e')+1:])
+	if exp < -4 || exp >= 16 {
+		return s
+	}
+
+	s = strconv.FormatFloat(f, 'f', -1, bitSize)
+	if !strings.ContainsAny(s, ".") {
+		s += ".0"
+	}
+	return s
+}
+
+func reprComplex(c complex128) string {
+	re, im := real(c), imag(c)
+	imagPart := strings.TrimSuffix(reprFloat(im, 64), ".0") + "j"
+	if re == 0 && !math.Signbit(re) {
+		return imagPart
+	}
+
+	realPart := strings.TrimSuffix(reprFloat(re, 64), ".0")
+	if !math.Signbit(im) || math.IsNaN(im) {
+		imagPart = "+" + imagPart
+	}
+	return "(" + realPart + imagPart + ")"
+}
+
+func reprQuote(s string) byte {
+	if strings.ContainsRune(s, '\'') && !strings.ContainsRune(s, '"') {
+		return '"'
+	}
+	return '\''
+}
+
+func reprString(s string) string {
+	quote := reprQuote(s)
+
+	var b strings.Builder
+	b.WriteByte(quote)
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			writeHexEscape(&b, 'x', uint64(s[i]), 2)
+			i++
+			continue
+		}
+		i += size
+
+		switch {
+		case r == rune(quote) || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r < ' ' || r == 0x7f:
+			writeHexEscape(&b, 'x', uint64(r), 2)
+		case r < utf8.RuneSelf || unicode.IsPrint(r):
+			b.WriteRune(r)
+		case r <= 0xff:
+			writeHexEscape(&b, 'x', uint64(r), 2)
+		case r <= 0xffff:
+			writeHexEscape(&b, 'u', uint64(r), 4)
+		default:
+			writeHexEscape(&b, 'U', uint64(r), 8)
+		}
+	}
+	b.WriteByte(quote)
+	return b.String()
+}
+
+func reprBytes(data []byte) string {
+	quote := reprQuote(string(data))
+
+	var b strings.Builder
+	b.WriteString("b")
+	b.WriteByte(quote)
+	for _, c := range data {
+		switch {
+		case c == quote || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c == '\t':
+			b.WriteString(`\t`)
+		case c == '\n':
+			b.WriteString(`\n`)
+		case c == '\r':
+			b.WriteString(`\r`)
+		case c < ' ' || c >= 0x7f:
+			writeHexEscape(&b, 'x', uint64(c), 2)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte(quote)
+	return b.String()
+}
+
+func writeHexEscape(b *strings.Builder, kind byte, value uint64, width int) {
+	hex := strconv.FormatUint(value, 16)
+	b.WriteByte('\\')
+	b.WriteByte(kind)
+	b.WriteString(strings.Repeat("0", width-len(hex)))
+	b.WriteString(hex)
+}
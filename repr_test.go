@@ -0,0 +1,118 @@
+package ezarr
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// Test | Repr verifies Python repr output for scalar values
+func TestReprScalars(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	cases := []struct {
+		value    interface{}
+		expected string
+	}{
+		{nil, "None"},
+		{true, "True"},
+		{false, "False"},
+		{42, "42"},
+		{uint8(7), "7"},
+		{huge, "123456789012345678901234567890"},
+		{0.1, "0.1"},
+		{1.0, "1.0"},
+		{1e16, "1e+16"},
+		{1e15, "1000000000000000.0"},
+		{123456789012345678.0, "1.2345678901234568e+17"},
+		{0.0001, "0.0001"},
+		{0.00001, "1e-05"},
+		{1.5e-7, "1.5e-07"},
+		{1.0 / 3, "0.3333333333333333"},
+		{float32(0.1), "0.1"},
+		{math.Inf(1), "inf"},
+		{math.NaN(), "nan"},
+		{math.Copysign(0, -1), "-0.0"},
+		{2i, "2j"},
+		{1 + 2i, "(1+2j)"},
+		{1.5 - 0.5i, "(1.5-0.5j)"},
+		{-1i, "-1j"},
+		{complex(1, math.Copysign(0, -1)), "(1-0j)"},
+		{complex(math.Copysign(0, -1), math.Copysign(0, -1)), "(-0-0j)"},
+		{"it's", `"it's"`},
+		{`say "hi" it's`, `'say "hi" it\'s'`},
+		{"tab\there\n", `'tab\there\n'`},
+		{"\x00\x7f", `'\x00\x7f'`},
+		{"héllo", "'héllo'"},
+		{"\u00a0", `'\xa0'`},
+		{"\u200b", `'\u200b'`},
+		{"\U0001F600", "'\U0001F600'"},
+		{"\U000e0001", `'\U000e0001'`},
+		{[]byte("ab'c\xff\n"), `b"ab'c\xff\n"`},
+	}
+
+	for _, c := range cases {
+		if got := Repr(c.value); got != c.expected {
+			t.Errorf("Repr(%#v) = %s, expected %s", c.value, got, c.expected)
+		}
+	}
+}
+
+// Test | Repr verifies Python repr output for nested containers and cycles
+func TestReprContainers(t *testing.T) {
+	dict, _ := NewDict("a", New(1, "b", nil), "c", true)
+	if got := Repr(dict); got != "{'a': [1, 'b', None], 'c': True}" {
+		t.Errorf("Unexpected dict repr: %s", got)
+	}
+
+	if got := Repr(New("a", nil, true)); got != "['a', None, True]" {
+		t.Errorf("Unexpected list repr: %s", got)
+	}
+
+	goValues := []interface{}{[]int{1, 2}, map[string]float64{"y": 2, "x": 1}}
+	if got := Repr(goValues); got != "[[1, 2], {'x': 1.0, 'y': 2.0}]" {
+		t.Errorf("Unexpected repr for Go slices and maps: %s", got)
+	}
+
+	list := New(1)
+	list.Append(list)
+	if got := Repr(list); got != "[1, [...]]" {
+		t.Errorf("Expected self-referencing list to render as [1, [...]], got %s", got)
+	}
+
+	self := &Dict{}
+	self.Set("self", self)
+	if got := Repr(self); got != "{'self': {...}}" {
+		t.Errorf("Expected self-referencing dict to render as {'self': {...}}, got %s", got)
+	}
+
+	shared := New(1)
+	if got := Repr(New(shared, shared)); got != "[[1], [1]]" {
+		t.Errorf("Expected a list repeated side by side not to be treated as a cycle, got %s", got)
+	}
+
+	typed := NewTypedList("x", "y")
+	if got := Repr(typed); got != "['x', 'y']" {
+		t.Errorf("Unexpected typed list repr: %s", got)
+	}
+}
+
+// Test | EnableRepr verifies that String switches to Python repr output
+func TestEnableRepr(t *testing.T) {
+	list := New("a", nil, true)
+	dict, _ := NewDict("k", "v")
+
+	if list.String() != "[a, <nil>, true]" {
+		t.Errorf("Expected default string output, got %s", list.String())
+	}
+
+	EnableRepr(true)
+	defer EnableRepr(false)
+
+	if list.String() != "['a', None, True]" {
+		t.Errorf("Expected repr output, got %s", list.String())
+	}
+	if dict.String() != "{'k': 'v'}" {
+		t.Errorf("Expected repr output, got %s", dict.String())
+	}
+}
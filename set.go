@@ -0,0 +1,311 @@
+package ezarr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Set is an insertion-ordered set. Elements are compared with Equal, the
+// same way Dict compares keys. The zero value is an empty set.
+type Set struct {
+	items Dict
+}
+
+// FrozenSet is an immutable Set. It implements Hasher, so it can be used
+// as a Dict key or as an element of another set.
+type FrozenSet struct {
+	set Set
+}
+
+// SetLike is implemented by *Set and *FrozenSet.
+type SetLike interface {
+	setItems() *Dict
+}
+
+func NewSet(elements ...interface{}) *Set {
+	s := &Set{}
+	for _, e := range elements {
+		s.Add(e)
+	}
+	return s
+}
+
+func SetFromList(l *List) *Set {
+	return NewSet(l.Elements...)
+}
+
+func (s *Set) setItems() *Dict {
+	return &s.items
+}
+
+func (s *Set) Add(element interface{}) *Set {
+	if index, h := s.items.lookup(element); index == -1 {
+		s.items.insert(h, element, nil)
+	}
+	return s
+}
+
+// Discard removes element if it is present. It shifts the elements added
+// after it to keep insertion order, so it takes O(n) time rather than the
+// O(1) of a Python set, and DifferenceUpdate and SymmetricDifferenceUpdate
+// take O(n·m) time.
+func (s *Set) Discard(element interface{}) *Set {
+	if index, _ := s.items.lookup(element); index != -1 {
+		s.items.removeAt(index)
+	}
+	return s
+}
+
+// Remove is Discard, with the same O(n) cost, except that a missing element
+// returns a KeyError.
+func (s *Set) Remove(element interface{}) error {
+	index, _ := s.items.lookup(element)
+	if index == -1 {
+		return keyNotFound(element)
+	}
+	s.items.removeAt(index)
+	return nil
+}
+
+func (s *Set) Pop() (interface{}, error) {
+	if s.items.Len() == 0 {
+		return nil, popFromEmptySet()
+	}
+	last := s.items.Len() - 1
+	element := s.items.Keys[last]
+	s.items.removeAt(last)
+	return element, nil
+}
+
+func (s *Set) Contains(element interface{}) bool {
+	index, _ := s.items.lookup(element)
+	return index != -1
+}
+
+func (s *Set) Len() int {
+	return s.items.Len()
+}
+
+func (s *Set) Clear() *Set {
+	s.items.Clear()
+	return s
+}
+
+func (s *Set) Copy() *Set {
+	return NewSet(s.items.Keys...)
+}
+
+func (s *Set) ToList() *List {
+	return s.items.GetKeys()
+}
+
+func (s *Set) Freeze() *FrozenSet {
+	return &FrozenSet{set: *s.Copy()}
+}
+
+func (s *Set) Union(others ...SetLike) *Set {
+	return s.Copy().Update(others...)
+}
+
+func (s *Set) Intersection(others ...SetLike) *Set {
+	return s.Copy().IntersectionUpdate(others...)
+}
+
+func (s *Set) Difference(others ...SetLike) *Set {
+	return s.Copy().DifferenceUpdate(others...)
+}
+
+func (s *Set) SymmetricDifference(other SetLike) *Set {
+	return s.Copy().SymmetricDifferenceUpdate(other)
+}
+
+func (s *Set) Update(others ...SetLike) *Set {
+	for _, other := range others {
+		for _, e := range other.setItems().Keys {
+			s.Add(e)
+		}
+	}
+	return s
+}
+
+func (s *Set) IntersectionUpdate(others ...SetLike) *Set {
+	for _, other := range others {
+		items := other.setItems()
+		kept := &Set{}
+		for _, e := range s.items.Keys {
+			if items.Contains(e) {
+				kept.Add(e)
+			}
+		}
+		s.items = kept.items
+	}
+	return s
+}
+
+func (s *Set) DifferenceUpdate(others ...SetLike) *Set {
+	for _, other := range others {
+		if other.setItems() == &s.items {
+			return s.Clear()
+		}
+		for _, e := range other.setItems().Keys {
+			s.Discard(e)
+		}
+	}
+	return s
+}
+
+func (s *Set) SymmetricDifferenceUpdate(other SetLike) *Set {
+	items := other.setItems()
+	if items == &s.items {
+		return s.Clear()
+	}
+	for _, e := range append([]interface{}{}, items.Keys...) {
+		if s.Contains(e) {
+			s.Discard(e)
+		} else {
+			s.Add(e)
+		}
+	}
+	return s
+}
+
+func (s *Set) IsSubset(other SetLike) bool {
+	return isSubset(&s.items, other.setItems())
+}
+
+func (s *Set) IsSuperset(other SetLike) bool {
+	return isSubset(other.setItems(), &s.items)
+}
+
+func (s *Set) IsDisjoint(other SetLike) bool {
+	return isDisjoint(&s.items, other.setItems())
+}
+
+// Equal reports whether s and other hold the same elements, regardless of
+// order. Unlike the package-level Equal, a Set can equal a FrozenSet.
+func (s *Set) Equal(other SetLike) bool {
+	items := other.setItems()
+	return s.items.Len() == items.Len() && isSubset(&s.items, items)
+}
+
+func (s *Set) String() string {
+	if reprStrings.Load() {
+		return Repr(s)
+	}
+	elements := make([]string, len(s.items.Keys))
+	for i, e := range s.items.Keys {
+		elements[i] = fmt.Sprintf("%v", e)
+	}
+	return "{" + strings.Join(elements, ", ") + "}"
+}
+
+func (s *Set) Repr() string {
+	return Repr(s)
+}
+
+func (s Set) hashElements() ([]interface{}, bool) {
+	return s.items.Keys, false
+}
+
+func NewFrozenSet(elements ...interface{}) *FrozenSet {
+	return &FrozenSet{set: *NewSet(elements...)}
+}
+
+func FrozenSetFromList(l *List) *FrozenSet {
+	return NewFrozenSet(l.Elements...)
+}
+
+func (f *FrozenSet) setItems() *Dict {
+	return &f.set.items
+}
+
+func (f *FrozenSet) Hash() uint64 {
+	return Hash(&f.set)
+}
+
+func (f *FrozenSet) Contains(element interface{}) bool {
+	return f.set.Contains(element)
+}
+
+func (f *FrozenSet) Len() int {
+	return f.set.Len()
+}
+
+func (f *FrozenSet) ToList() *List {
+	return f.set.ToList()
+}
+
+func (f *FrozenSet) ToSet() *Set {
+	return f.set.Copy()
+}
+
+func (f *FrozenSet) Union(others ...SetLike) *FrozenSet {
+	return &FrozenSet{set: *f.set.Union(others...)}
+}
+
+func (f *FrozenSet) Intersection(others ...SetLike) *FrozenSet {
+	return &FrozenSet{set: *f.set.Intersection(others...)}
+}
+
+func (f *FrozenSet) Difference(others ...SetLike) *FrozenSet {
+	return &FrozenSet{set: *f.set.Difference(others...)}
+}
+
+func (f *FrozenSet) SymmetricDifference(other SetLike) *FrozenSet {
+	return &FrozenSet{set: *f.set.SymmetricDifference(other)}
+}
+
+func (f *FrozenSet) IsSubset(other SetLike) bool {
+	return f.set.IsSubset(other)
+}
+
+func (f *FrozenSet) IsSuperset(other SetLike) bool {
+	return f.set.IsSuperset(other)
+}
+
+func (f *FrozenSet) IsDisjoint(other SetLike) bool {
+	return f.set.IsDisjoint(other)
+}
+
+func (f *FrozenSet) Equal(other SetLike) bool {
+	return f.set.Equal(other)
+}
+
+func (f *FrozenSet) String() string {
+	if reprStrings.Load() {
+		return Repr(f)
+	}
+	return "frozenset(" + f.set.String() + ")"
+}
+
+func (f *FrozenSet) Repr() string {
+	return Repr(f)
+}
+
+func (f FrozenSet) hashElements() ([]interface{}, bool) {
+	return f.set.hashElements()
+}
+
+func isSubset(a, b *Dict) bool {
+	if a.Len() > b.Len() {
+		return false
+	}
+	for _, e := range a.Keys {
+		if !b.Contains(e) {
+			return false
+		}
+	}
+	return true
+}
+
+func isDisjoint(a, b *Dict) bool {
+	if a.Len() > b.Len() {
+		a, b = b, a
+	}
+	for _, e := range a.Keys {
+		if b.Contains(e) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,201 @@
+package ezarr
+
+import (
+	"errors"
+	"testing"
+)
+
+// Test | Set verifies adding, removing and popping elements
+func TestSetBasics(t *testing.T) {
+	set := NewSet(1, "a", New(1, 2), 1)
+	if set.Len() != 3 {
+		t.Errorf("Expected duplicates to be dropped, got %v", set)
+	}
+	if !set.Contains(New(1, 2)) || set.Contains(2) {
+		t.Errorf("Expected membership to use Equal, got %v", set)
+	}
+
+	set.Add("a").Add(2)
+	if set.Len() != 4 {
+		t.Errorf("Expected length 4, got %d", set.Len())
+	}
+
+	set.Discard("missing").Discard(1)
+	if set.Contains(1) || set.Len() != 3 {
+		t.Errorf("Expected 1 to be discarded, got %v", set)
+	}
+
+	err := set.Remove("missing")
+	var keyErr *KeyError
+	if !errors.As(err, &keyErr) || keyErr.Key != "missing" {
+		t.Errorf("Expected KeyError for a missing element, got %v", err)
+	}
+
+	popped, err := set.Pop()
+	if err != nil || popped != 2 || set.Contains(2) {
+		t.Errorf("Expected to pop 2, got %v, error: %v", popped, err)
+	}
+
+	var empty Set
+	if _, err := empty.Pop(); !errors.Is(err, ErrKey) || !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected empty KeyError popping from a zero Set, got %v", err)
+	}
+
+	fromKeys := SetFromList(FromKeys([]interface{}{"x", "y"}, true).GetKeys())
+	if !fromKeys.Equal(NewSet("y", "x")) {
+		t.Errorf("Expected a set of dict keys, got %v", fromKeys)
+	}
+}
+
+// Test | Set verifies union, intersection and differences against Python results
+func TestSetAlgebra(t *testing.T) {
+	a := NewSet(1, 2, 3, 4)
+	b := NewSet(3, 4, 5)
+	c := NewSet(4, 6)
+
+	cases := []struct {
+		name     string
+		got      *Set
+		expected *Set
+	}{
+		{"union", a.Union(b, c), NewSet(1, 2, 3, 4, 5, 6)},
+		{"intersection", a.Intersection(b, c), NewSet(4)},
+		{"difference", a.Difference(b, c), NewSet(1, 2)},
+		{"symmetric difference", a.SymmetricDifference(b), NewSet(1, 2, 5)},
+		{"union with frozenset", a.Union(NewFrozenSet(9)), NewSet(1, 2, 3, 4, 9)},
+		{"no arguments", a.Intersection(), a},
+	}
+	for _, c := range cases {
+		if !Equal(c.got, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, c.got)
+		}
+	}
+
+	if a.Len() != 4 {
+		t.Errorf("Expected the operands to be unchanged, got %v", a)
+	}
+
+	if Repr(a.Union(b)) != "{1, 2, 3, 4, 5}" {
+		t.Errorf("Expected union to keep insertion order, got %s", Repr(a.Union(b)))
+	}
+}
+
+// Test | Set verifies the in-place variants, including with itself as operand
+func TestSetInPlace(t *testing.T) {
+	s := NewSet(1, 2, 3)
+	s.Update(NewSet(3, 4)).IntersectionUpdate(NewSet(2, 3, 4, 5)).DifferenceUpdate(NewSet(3))
+	if !Equal(s, NewSet(2, 4)) {
+		t.Errorf("Expected {2, 4}, got %v", s)
+	}
+
+	s.SymmetricDifferenceUpdate(NewSet(4, 6))
+	if !Equal(s, NewSet(2, 6)) {
+		t.Errorf("Expected {2, 6}, got %v", s)
+	}
+
+	if s.Update(s); s.Len() != 2 {
+		t.Errorf("Expected updating with itself to be a no-op, got %v", s)
+	}
+	if s.Copy().DifferenceUpdate(s).Len() != 0 || s.Len() != 2 {
+		t.Errorf("Expected only the copy to change, got %v", s)
+	}
+	if s.DifferenceUpdate(s); s.Len() != 0 {
+		t.Errorf("Expected difference with itself to be empty, got %v", s)
+	}
+
+	s = NewSet(1, 2)
+	if s.SymmetricDifferenceUpdate(s); s.Len() != 0 {
+		t.Errorf("Expected symmetric difference with itself to be empty, got %v", s)
+	}
+}
+
+// Test | Set verifies subset, superset and disjoint checks
+func TestSetPredicates(t *testing.T) {
+	small := NewSet(1, 2)
+	big := NewSet(1, 2, 3)
+
+	if !small.IsSubset(big) || big.IsSubset(small) || !small.IsSubset(small) {
+		t.Error("Unexpected IsSubset result")
+	}
+	if !big.IsSuperset(small) || small.IsSuperset(big) {
+		t.Error("Unexpected IsSuperset result")
+	}
+	if small.IsDisjoint(big) || !small.IsDisjoint(NewSet(3, 4)) || !(&Set{}).IsDisjoint(&Set{}) {
+		t.Error("Unexpected IsDisjoint result")
+	}
+}
+
+// Test | Equal verifies that sets compare and hash regardless of order
+func TestSetEqual(t *testing.T) {
+	a := NewSet("x", New(1), 3)
+	b := NewSet(3, "x", New(1))
+
+	if !Equal(a, b) || Hash(a) != Hash(b) {
+		t.Errorf("Expected %v and %v to be equal and hash alike", a, b)
+	}
+	if Equal(a, NewSet("x", New(1))) || Equal(a, NewSet("x", New(2), 3)) {
+		t.Error("Expected sets with different elements to be unequal")
+	}
+	if Equal(New(a), New(b.Copy().Add(4))) {
+		t.Error("Expected nested sets with different elements to be unequal")
+	}
+
+	if Equal(a, a.Freeze()) || !a.Equal(a.Freeze()) || !a.Freeze().Equal(b) {
+		t.Error("Expected the Equal method, but not the Equal function, to match Set and FrozenSet")
+	}
+}
+
+// Test | FrozenSet verifies hashing and use as a Dict key or set element
+func TestFrozenSet(t *testing.T) {
+	f1 := NewFrozenSet(1, 2, 3)
+	f2 := FrozenSetFromList(New(3, 2, 1, 1))
+
+	if f1.Hash() != f2.Hash() || Hash(f1) != Hash(f2) || !Equal(f1, f2) {
+		t.Errorf("Expected %v and %v to be equal and hash alike", f1, f2)
+	}
+
+	dict := &Dict{}
+	dict.Set(f1, "first")
+	dict.Set(f2, "second")
+	if dict.Len() != 1 || dict.GetDefault(NewFrozenSet(2, 1, 3), nil) != "second" {
+		t.Errorf("Expected equal frozensets to share a key, got %v", dict)
+	}
+
+	nested := NewSet(f1, f2, NewFrozenSet())
+	if nested.Len() != 2 {
+		t.Errorf("Expected equal frozensets to be one element, got %v", nested)
+	}
+
+	union := f1.Union(NewSet(4))
+	if union.Len() != 4 || f1.Len() != 3 {
+		t.Errorf("Expected union to return a new frozenset, got %v and %v", union, f1)
+	}
+
+	thawed := f1.ToSet().Add(9)
+	if f1.Contains(9) || !thawed.Contains(9) {
+		t.Error("Expected ToSet to return an independent copy")
+	}
+}
+
+// Test | Repr verifies Python-style set and frozenset output
+func TestSetRepr(t *testing.T) {
+	cases := []struct {
+		value    interface{}
+		expected string
+	}{
+		{&Set{}, "set()"},
+		{NewSet(1, "a"), "{1, 'a'}"},
+		{NewFrozenSet(), "frozenset()"},
+		{NewFrozenSet(1, 2), "frozenset({1, 2})"},
+		{New(NewSet(NewFrozenSet(1))), "[{frozenset({1})}]"},
+	}
+	for _, c := range cases {
+		if got := Repr(c.value); got != c.expected {
+			t.Errorf("Expected %s, got %s", c.expected, got)
+		}
+	}
+
+	if NewSet(1, 2).String() != "{1, 2}" || NewFrozenSet(1).String() != "frozenset({1})" {
+		t.Errorf("Unexpected String output: %s, %s", NewSet(1, 2), NewFrozenSet(1))
+	}
+}
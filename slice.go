@@ -0,0 +1,142 @@
+package ezarr
+
+import (
+	"fmt"
+	"math"
+)
+
+// Omit stands for a missing slice bound, like leaving out start or stop in
+// Python's l[start:stop:step].
+const Omit = math.MinInt
+
+func (l *List) SliceStep(start, stop, step int) (*List, error) {
+	start, _, step, n, err := sliceIndices(len(l.Elements), start, stop, step)
+	if err != nil {
+		return nil, err
+	}
+
+	elements := make([]interface{}, n)
+	for i := range elements {
+		elements[i] = l.Elements[start+i*step]
+	}
+	return &List{Elements: elements}, nil
+}
+
+func (l *List) SetSlice(start, stop, step int, other *List) error {
+	start, stop, step, n, err := sliceIndices(len(l.Elements), start, stop, step)
+	if err != nil {
+		return err
+	}
+
+	values := make([]interface{}, len(other.Elements))
+	copy(values, other.Elements)
+
+	if step == 1 {
+		if stop < start {
+			stop = start
+		}
+		elements := make([]interface{}, 0, len(l.Elements)-(stop-start)+len(values))
+		elements = append(elements, l.Elements[:start]...)
+		elements = append(elements, values...)
+		elements = append(elements, l.Elements[stop:]...)
+		l.Elements = elements
+		return nil
+	}
+
+	if len(values) != n {
+		return &ValueError{
+			Value: other,
+			Msg:   fmt.Sprintf("attempt to assign sequence of size %d to extended slice of size %d", len(values), n),
+		}
+	}
+	for i, v := range values {
+		l.Elements[start+i*step] = v
+	}
+	return nil
+}
+
+func (l *List) DelSlice(start, stop, step int) error {
+	start, _, step, n, err := sliceIndices(len(l.Elements), start, stop, step)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+
+	if step < 0 {
+		start, step = start+(n-1)*step, -step
+	}
+
+	kept := l.Elements[:start]
+	for i := start; i < len(l.Elements); i++ {
+		if i <= start+(n-1)*step && (i-start)%step == 0 {
+			continue
+		}
+		kept = append(kept, l.Elements[i])
+	}
+	for i := len(kept); i < len(l.Elements); i++ {
+		l.Elements[i] = nil
+	}
+	l.Elements = kept
+	return nil
+}
+
+// sliceIndices normalises slice bounds exactly like CPython's
+// PySlice_Unpack and PySlice_AdjustIndices, and returns the number of
+// elements the slice selects.
+func sliceIndices(length, start, stop, step int) (int, int, int, int, error) {
+	if step == Omit {
+		step = 1
+	}
+	if step == 0 {
+		return 0, 0, 0, 0, &ValueError{Value: step, Msg: "slice step cannot be zero"}
+	}
+
+	if start == Omit {
+		if step < 0 {
+			start = math.MaxInt
+		} else {
+			start = 0
+		}
+	}
+	if stop == Omit {
+		if step < 0 {
+			stop = math.MinInt
+		} else {
+			stop = math.MaxInt
+		}
+	}
+
+	start = adjustIndex(length, start, step)
+	stop = adjustIndex(length, stop, step)
+
+	n := 0
+	if step < 0 {
+		if stop < start {
+			n = (start-stop-1)/(-step) + 1
+		}
+	} else if start < stop {
+		n = (stop-start-1)/step + 1
+	}
+	return start, stop, step, n, nil
+}
+
+func adjustIndex(length, index, step int) int {
+	if index < 0 {
+		if index < -length {
+			if step < 0 {
+				return -1
+			}
+			return 0
+		}
+		return index + length
+	}
+	if index >= length {
+		if step < 0 {
+			return length - 1
+		}
+		return length
+	}
+	return index
+}
@@ -0,0 +1,146 @@
+package ezarr
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Expected results were produced by CPython on list(range(6)).
+var sliceCases = []struct {
+	start, stop, step int
+	expected          []interface{}
+}{
+	{Omit, Omit, Omit, []interface{}{0, 1, 2, 3, 4, 5}}, // [::]
+	{1, 4, Omit, []interface{}{1, 2, 3}},                // [1:4]
+	{4, 1, Omit, []interface{}{}},                       // [4:1]
+	{-3, -1, Omit, []interface{}{3, 4}},                 // [-3:-1]
+	{-1, -3, Omit, []interface{}{}},                     // [-1:-3]
+	{-10, 10, Omit, []interface{}{0, 1, 2, 3, 4, 5}},    // [-10:10]
+	{2, 2, Omit, []interface{}{}},                       // [2:2]
+	{Omit, Omit, 2, []interface{}{0, 2, 4}},             // [::2]
+	{1, Omit, 2, []interface{}{1, 3, 5}},                // [1::2]
+	{Omit, Omit, -1, []interface{}{5, 4, 3, 2, 1, 0}},   // [::-1]
+	{4, 1, -1, []interface{}{4, 3, 2}},                  // [4:1:-1]
+	{1, 4, -1, []interface{}{}},                         // [1:4:-1]
+	{-1, -4, -1, []interface{}{5, 4, 3}},                // [-1:-4:-1]
+	{Omit, 1, -2, []interface{}{5, 3}},                  // [:1:-2]
+	{5, Omit, -2, []interface{}{5, 3, 1}},               // [5::-2]
+	{10, -10, -3, []interface{}{5, 2}},                  // [10:-10:-3]
+	{-10, 10, 3, []interface{}{0, 3}},                   // [-10:10:3]
+	{Omit, -1, 3, []interface{}{0, 3}},                  // [:-1:3]
+	{-2, Omit, -1, []interface{}{4, 3, 2, 1, 0}},        // [-2::-1]
+	{0, 0, -1, []interface{}{}},                         // [0:0:-1]
+	{3, Omit, Omit, []interface{}{3, 4, 5}},             // [3:]
+	{Omit, 3, Omit, []interface{}{0, 1, 2}},             // [:3]
+	{Omit, -10, -1, []interface{}{5, 4, 3, 2, 1, 0}},    // [:-10:-1]
+	{Omit, -10, Omit, []interface{}{}},                  // [:-10]
+	{6, Omit, -1, []interface{}{5, 4, 3, 2, 1, 0}},      // [6::-1]
+	{-6, Omit, -1, []interface{}{0}},                    // [-6::-1]
+	{1, 5, 2, []interface{}{1, 3}},                      // [1:5:2]
+	{5, 1, -2, []interface{}{5, 3}},                     // [5:1:-2]
+}
+
+// Test | SliceStep verifies extended slicing against CPython results
+func TestSliceStep(t *testing.T) {
+	list := New(0, 1, 2, 3, 4, 5)
+
+	for _, c := range sliceCases {
+		got, err := list.SliceStep(c.start, c.stop, c.step)
+		if err != nil {
+			t.Errorf("SliceStep(%d, %d, %d) returned error: %v", c.start, c.stop, c.step, err)
+			continue
+		}
+		if !reflect.DeepEqual(got.Elements, c.expected) {
+			t.Errorf("SliceStep(%d, %d, %d) = %v, expected %v", c.start, c.stop, c.step, got.Elements, c.expected)
+		}
+	}
+
+	if _, err := list.SliceStep(0, 3, 0); err == nil {
+		t.Error("Expected error for zero step, got nil")
+	}
+}
+
+// Test | Slice verifies two-bound slicing against the CPython results that use a unit step
+func TestSliceMatchesCPython(t *testing.T) {
+	list := New(0, 1, 2, 3, 4, 5)
+
+	for _, c := range sliceCases {
+		if c.step != Omit || c.start == Omit || c.stop == Omit {
+			continue
+		}
+		got := list.Slice(c.start, c.stop)
+		if !reflect.DeepEqual(got.Elements, c.expected) {
+			t.Errorf("Slice(%d, %d) = %v, expected %v", c.start, c.stop, got.Elements, c.expected)
+		}
+	}
+}
+
+// Test | SetSlice verifies slice assignment, including extended-slice length checks
+func TestSetSlice(t *testing.T) {
+	cases := []struct {
+		start, stop, step int
+		values            []interface{}
+		expected          []interface{}
+	}{
+		{1, 3, Omit, []interface{}{9}, []interface{}{0, 9, 3, 4, 5}},
+		{1, 1, Omit, []interface{}{7, 8}, []interface{}{0, 7, 8, 1, 2, 3, 4, 5}},
+		{4, 1, Omit, []interface{}{9}, []interface{}{0, 1, 2, 3, 9, 4, 5}},
+		{Omit, Omit, 2, []interface{}{"a", "b", "c"}, []interface{}{"a", 1, "b", 3, "c", 5}},
+		{Omit, Omit, -1, []interface{}{9, 8, 7, 6, 5, 4}, []interface{}{4, 5, 6, 7, 8, 9}},
+		{-2, Omit, Omit, []interface{}{}, []interface{}{0, 1, 2, 3}},
+	}
+
+	for _, c := range cases {
+		list := New(0, 1, 2, 3, 4, 5)
+		if err := list.SetSlice(c.start, c.stop, c.step, New(c.values...)); err != nil {
+			t.Errorf("SetSlice(%d, %d, %d) returned error: %v", c.start, c.stop, c.step, err)
+			continue
+		}
+		if !reflect.DeepEqual(list.Elements, c.expected) {
+			t.Errorf("SetSlice(%d, %d, %d) = %v, expected %v", c.start, c.stop, c.step, list.Elements, c.expected)
+		}
+	}
+
+	list := New(0, 1, 2, 3, 4, 5)
+	err := list.SetSlice(Omit, Omit, 2, New(1, 2))
+	expected := "attempt to assign sequence of size 2 to extended slice of size 3"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got %v", expected, err)
+	}
+
+	list.SetSlice(Omit, Omit, Omit, list)
+	if list.Len() != 6 {
+		t.Errorf("Expected assigning a list to its own full slice to keep it intact, got %v", list)
+	}
+}
+
+// Test | DelSlice verifies deleting simple and extended slices
+func TestDelSlice(t *testing.T) {
+	cases := []struct {
+		start, stop, step int
+		expected          []interface{}
+	}{
+		{1, 3, Omit, []interface{}{0, 3, 4, 5}},
+		{Omit, Omit, 2, []interface{}{1, 3, 5}},
+		{Omit, Omit, -2, []interface{}{0, 2, 4}},
+		{4, 0, -2, []interface{}{0, 1, 3, 5}},
+		{-10, 10, Omit, []interface{}{}},
+		{3, 1, Omit, []interface{}{0, 1, 2, 3, 4, 5}},
+		{1, Omit, 3, []interface{}{0, 2, 3, 5}},
+	}
+
+	for _, c := range cases {
+		list := New(0, 1, 2, 3, 4, 5)
+		if err := list.DelSlice(c.start, c.stop, c.step); err != nil {
+			t.Errorf("DelSlice(%d, %d, %d) returned error: %v", c.start, c.stop, c.step, err)
+			continue
+		}
+		if !reflect.DeepEqual(list.Elements, c.expected) {
+			t.Errorf("DelSlice(%d, %d, %d) = %v, expected %v", c.start, c.stop, c.step, list.Elements, c.expected)
+		}
+	}
+
+	if err := New(1).DelSlice(0, 1, 0); err == nil {
+		t.Error("Expected error for zero step, got nil")
+	}
+}
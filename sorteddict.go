@@ -0,0 +1,314 @@
+package ezarr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SortedDict is a Dict whose keys are kept sorted, like
+// sortedcontainers.SortedDict in Python. Keys are ordered with Compare, as
+// in List.Sort, and looked up by hash like the keys of a Dict. Keys that
+// compare equal, such as 1 and 1.0, are the same key.
+//
+// Each key and value is held by an entry that is stored both in a Dict
+// index, for lookups, and in a SortedList, for ordered access.
+type SortedDict struct {
+	index   Dict
+	entries SortedList
+}
+
+type sortedEntry struct {
+	key, value interface{}
+}
+
+func NewSortedDict(pairs ...interface{}) (*SortedDict, error) {
+	if len(pairs)%2 != 0 {
+		return nil, &ValueError{Value: len(pairs), Msg: "number of arguments must be even"}
+	}
+
+	d := &SortedDict{}
+	for i := 0; i < len(pairs); i += 2 {
+		if err := d.Set(pairs[i], pairs[i+1]); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// SortedDictFromDict returns a SortedDict holding the items of d.
+func SortedDictFromDict(d *Dict) (*SortedDict, error) {
+	s := &SortedDict{}
+	if err := s.Update(d); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (d *SortedDict) Get(key interface{}) (interface{}, error) {
+	entry := d.lookup(key)
+	if entry == nil {
+		return nil, keyNotFound(key)
+	}
+	return entry.value, nil
+}
+
+func (d *SortedDict) GetDefault(key, defaultValue interface{}) interface{} {
+	if value, err := d.Get(key); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+// Set adds or replaces the value of key. A key that compares equal to one
+// already present, as 1.0 does to 1, replaces its value and the original
+// key is kept, like a Python dict. It returns a TypeError when key cannot
+// be compared with the keys already present.
+func (d *SortedDict) Set(key, value interface{}) error {
+	if entry := d.lookup(key); entry != nil {
+		entry.value = value
+		return nil
+	}
+
+	entry := &sortedEntry{key: key, value: value}
+	d.entries.key = entryKey
+	if err := d.entries.Add(entry); err != nil {
+		return err
+	}
+	d.index.insert(Hash(key), key, entry)
+	return nil
+}
+
+func (d *SortedDict) Delete(key interface{}) error {
+	_, err := d.Pop(key)
+	return err
+}
+
+func (d *SortedDict) Pop(key interface{}) (interface{}, error) {
+	entry := d.lookup(key)
+	if entry == nil {
+		return nil, keyNotFound(key)
+	}
+
+	if err := d.entries.Remove(entry); err != nil {
+		return nil, err
+	}
+	index, _ := d.index.lookup(entry.key)
+	d.index.removeAt(index)
+	return entry.value, nil
+}
+
+// PopItem removes and returns the item with the largest key.
+func (d *SortedDict) PopItem() (interface{}, interface{}, error) {
+	if d.Len() == 0 {
+		return nil, nil, dictIsEmpty()
+	}
+
+	e, _ := d.entries.Pop(-1)
+	entry := e.(*sortedEntry)
+	index, _ := d.index.lookup(entry.key)
+	d.index.removeAt(index)
+	return entry.key, entry.value, nil
+}
+
+// PeekItem returns the item at position index in key order; -1 is the
+// item with the largest key.
+func (d *SortedDict) PeekItem(index int) (interface{}, interface{}, error) {
+	e, err := d.entries.Get(index)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry := e.(*sortedEntry)
+	return entry.key, entry.value, nil
+}
+
+// Index returns the position of key in key order, or a ValueError when key
+// is not present.
+func (d *SortedDict) Index(key interface{}) (int, error) {
+	entry := d.lookup(key)
+	if entry == nil {
+		return -1, notInList(key)
+	}
+	return d.entries.Index(entry)
+}
+
+// Floor returns the largest key less than or equal to key, or a KeyError
+// when there is none.
+func (d *SortedDict) Floor(key interface{}) (interface{}, error) {
+	i, err := d.entries.BisectRight(&sortedEntry{key: key})
+	if err != nil {
+		return nil, err
+	}
+	if i == 0 {
+		return nil, keyNotFound(key)
+	}
+	e, _ := d.entries.Get(i - 1)
+	return e.(*sortedEntry).key, nil
+}
+
+// Ceiling returns the smallest key greater than or equal to key, or a
+// KeyError when there is none.
+func (d *SortedDict) Ceiling(key interface{}) (interface{}, error) {
+	i, err := d.entries.BisectLeft(&sortedEntry{key: key})
+	if err != nil {
+		return nil, err
+	}
+	if i == d.Len() {
+		return nil, keyNotFound(key)
+	}
+	e, _ := d.entries.Get(i)
+	return e.(*sortedEntry).key, nil
+}
+
+// IRange returns the keys between lo and hi, both included, in order. A
+// nil bound leaves that side of the range open.
+func (d *SortedDict) IRange(lo, hi interface{}) (*List, error) {
+	var loEntry, hiEntry interface{}
+	if lo != nil {
+		loEntry = &sortedEntry{key: lo}
+	}
+	if hi != nil {
+		hiEntry = &sortedEntry{key: hi}
+	}
+	entries, err := d.entries.IRange(loEntry, hiEntry)
+	if err != nil {
+		return nil, err
+	}
+	for i, e := range entries.Elements {
+		entries.Elements[i] = e.(*sortedEntry).key
+	}
+	return entries, nil
+}
+
+func (d *SortedDict) Contains(key interface{}) bool {
+	return d.lookup(key) != nil
+}
+
+func (d *SortedDict) Len() int {
+	return d.index.Len()
+}
+
+func (d *SortedDict) Clear() *SortedDict {
+	d.index.Clear()
+	d.entries.Clear()
+	return d
+}
+
+func (d *SortedDict) GetKeys() *List {
+	keys := d.entries.elements()
+	for i, e := range keys {
+		keys[i] = e.(*sortedEntry).key
+	}
+	return &List{Elements: keys}
+}
+
+func (d *SortedDict) GetValues() *List {
+	values := d.entries.elements()
+	for i, e := range values {
+		values[i] = e.(*sortedEntry).value
+	}
+	return &List{Elements: values}
+}
+
+func (d *SortedDict) GetItems() *List {
+	items := d.entries.elements()
+	for i, e := range items {
+		entry := e.(*sortedEntry)
+		items[i] = []interface{}{entry.key, entry.value}
+	}
+	return &List{Elements: items}
+}
+
+func (d *SortedDict) GetItemTuples() *List {
+	items := d.entries.elements()
+	for i, e := range items {
+		entry := e.(*sortedEntry)
+		items[i] = NewTuple(entry.key, entry.value)
+	}
+	return &List{Elements: items}
+}
+
+// Update sets every item of other. Items set before a comparison error are
+// kept.
+func (d *SortedDict) Update(other *Dict) error {
+	for i, key := range other.Keys {
+		if err := d.Set(key, other.Values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *SortedDict) Filter(filterFunc func(key, value interface{}) bool) *SortedDict {
+	result := &SortedDict{}
+	for _, e := range d.entries.elements() {
+		entry := e.(*sortedEntry)
+		if filterFunc(entry.key, entry.value) {
+			result.Set(entry.key, entry.value)
+		}
+	}
+	return result
+}
+
+func (d *SortedDict) Copy() *SortedDict {
+	return d.Filter(func(key, value interface{}) bool { return true })
+}
+
+// ToDict returns a Dict holding the items in key order.
+func (d *SortedDict) ToDict() *Dict {
+	result := &Dict{Keys: d.GetKeys().Elements, Values: d.GetValues().Elements}
+	result.index.rebuild(result.Keys)
+	return result
+}
+
+func (d *SortedDict) String() string {
+	if reprStrings.Load() {
+		return Repr(d)
+	}
+	pairs := make([]string, 0, d.Len())
+	for _, e := range d.entries.elements() {
+		entry := e.(*sortedEntry)
+		pairs = append(pairs, fmt.Sprintf("%v: %v", entry.key, entry.value))
+	}
+	return "SortedDict({" + strings.Join(pairs, ", ") + "})"
+}
+
+func (d *SortedDict) Repr() string {
+	return Repr(d)
+}
+
+// hashElements lists keys and values in key order, so SortedDicts are Equal
+// whatever order their items were set in.
+func (d SortedDict) hashElements() ([]interface{}, bool) {
+	entries := d.entries.elements()
+	elements := make([]interface{}, 0, 2*len(entries))
+	for _, e := range entries {
+		entry := e.(*sortedEntry)
+		elements = append(elements, entry.key, entry.value)
+	}
+	return elements, true
+}
+
+// lookup returns the entry whose key is Equal to key or, failing that, is
+// == to it in Python, or nil when there is none. NaN equals no key.
+func (d *SortedDict) lookup(key interface{}) *sortedEntry {
+	if index, _ := d.index.lookup(key); index != -1 {
+		return d.index.Values[index].(*sortedEntry)
+	}
+	if d.entries.Len() == 0 {
+		return nil
+	}
+	i, err := d.entries.BisectLeft(&sortedEntry{key: key})
+	if err != nil || i == d.entries.Len() {
+		return nil
+	}
+	e, _ := d.entries.Get(i)
+	entry := e.(*sortedEntry)
+	if !valuesEqual(entry.key, key) {
+		return nil
+	}
+	return entry
+}
+
+func entryKey(e interface{}) interface{} {
+	return e.(*sortedEntry).key
+}
@@ -0,0 +1,151 @@
+package ezarr
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// Test | SortedDict verifies the Dict methods and key order
+func TestSortedDict(t *testing.T) {
+	d, err := NewSortedDict("b", 2, "c", 3, "a", 1)
+	if err != nil {
+		t.Fatalf("NewSortedDict returned error: %v", err)
+	}
+	d.Set("b", 20)
+
+	if Repr(d) != "SortedDict({'a': 1, 'b': 20, 'c': 3})" {
+		t.Errorf("Unexpected contents: %s", Repr(d))
+	}
+	if !Equal(d.GetKeys(), New("a", "b", "c")) || !Equal(d.GetValues(), New(1, 20, 3)) {
+		t.Errorf("Expected keys and values in key order, got %v and %v", d.GetKeys(), d.GetValues())
+	}
+	if Repr(d.GetItemTuples()) != "[('a', 1), ('b', 20), ('c', 3)]" {
+		t.Errorf("Unexpected items: %s", Repr(d.GetItemTuples()))
+	}
+
+	if v, err := d.Get("c"); err != nil || v != 3 {
+		t.Errorf("Expected 3, got %v, error: %v", v, err)
+	}
+	if _, err := d.Get("z"); !errors.Is(err, ErrKey) {
+		t.Errorf("Expected KeyError, got %v", err)
+	}
+	if d.GetDefault("z", 0) != 0 || !d.Contains("a") || d.Len() != 3 {
+		t.Error("Unexpected GetDefault, Contains or Len result")
+	}
+
+	if v, err := d.Pop("b"); err != nil || v != 20 || d.Contains("b") {
+		t.Errorf("Expected to pop 20, got %v, error: %v", v, err)
+	}
+	if err := d.Delete("b"); !errors.Is(err, ErrKey) {
+		t.Errorf("Expected KeyError deleting a missing key, got %v", err)
+	}
+	if k, v, err := d.PopItem(); err != nil || k != "c" || v != 3 {
+		t.Errorf("Expected PopItem to return the largest key, got %v: %v, error: %v", k, v, err)
+	}
+
+	if err := d.Set(1, "one"); !errors.Is(err, ErrType) || d.Contains(1) {
+		t.Errorf("Expected TypeError for an incomparable key, got %v", err)
+	}
+	d.Clear()
+	if _, _, err := d.PopItem(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected empty KeyError, got %v", err)
+	}
+	numbers, _ := NewSortedDict(1, "a", 2, "c")
+	numbers.Set(1.0, "b")
+	if Repr(numbers) != "SortedDict({1: 'b', 2: 'c'})" {
+		t.Errorf("Expected 1.0 to replace the value of 1, got %s", Repr(numbers))
+	}
+	if v, err := numbers.Get(2.0); err != nil || v != "c" || !numbers.Contains(1.0) {
+		t.Errorf("Expected keys that compare equal to be found, got %v, error: %v", v, err)
+	}
+	if i, err := numbers.Index(2.0); err != nil || i != 1 {
+		t.Errorf("Expected index 1, got %d, error: %v", i, err)
+	}
+	if v, err := numbers.Pop(1.0); err != nil || v != "b" || numbers.Len() != 1 {
+		t.Errorf("Expected to pop b, got %v, error: %v", v, err)
+	}
+	if numbers.Contains(1) || numbers.Contains("x") {
+		t.Error("Expected popped and incomparable keys to be missing")
+	}
+	floats, _ := NewSortedDict(1, "a", 2.5, "b")
+	if _, err := floats.Get(math.NaN()); !errors.Is(err, ErrKey) || floats.Contains(math.NaN()) {
+		t.Errorf("Expected NaN to match no key, got %v", err)
+	}
+	floats.Set(math.NaN(), "nan")
+	if v, _ := floats.Get(1); v != "a" || floats.Len() != 3 {
+		t.Errorf("Expected NaN to be added as a new key, got %s", Repr(floats))
+	}
+}
+
+// Test | SortedDict verifies Floor, Ceiling, IRange, PeekItem and Index
+func TestSortedDictRange(t *testing.T) {
+	d, _ := NewSortedDict(10, "a", 20, "b", 30, "c", 40, "d")
+
+	cases := []struct {
+		key            interface{}
+		floor, ceiling interface{}
+	}{
+		{20, 20, 20},
+		{25, 20, 30},
+		{5, nil, 10},
+		{45, 40, nil},
+	}
+	for _, c := range cases {
+		floor, err := d.Floor(c.key)
+		if c.floor == nil && !errors.Is(err, ErrKey) || c.floor != nil && floor != c.floor {
+			t.Errorf("Floor(%v): expected %v, got %v, error: %v", c.key, c.floor, floor, err)
+		}
+		ceiling, err := d.Ceiling(c.key)
+		if c.ceiling == nil && !errors.Is(err, ErrKey) || c.ceiling != nil && ceiling != c.ceiling {
+			t.Errorf("Ceiling(%v): expected %v, got %v, error: %v", c.key, c.ceiling, ceiling, err)
+		}
+	}
+
+	if got, err := d.IRange(15, 30); err != nil || !Equal(got, New(20, 30)) {
+		t.Errorf("Expected [20, 30], got %v, error: %v", got, err)
+	}
+	if got, _ := d.IRange(nil, 20); !Equal(got, New(10, 20)) {
+		t.Errorf("Expected [10, 20], got %v", got)
+	}
+
+	if k, v, err := d.PeekItem(-1); err != nil || k != 40 || v != "d" {
+		t.Errorf("Expected 40: d, got %v: %v, error: %v", k, v, err)
+	}
+	if _, _, err := d.PeekItem(4); !errors.Is(err, ErrIndex) {
+		t.Errorf("Expected IndexError, got %v", err)
+	}
+	if i, err := d.Index(30); err != nil || i != 2 {
+		t.Errorf("Expected index 2, got %d, error: %v", i, err)
+	}
+	if _, err := d.Index(35); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError, got %v", err)
+	}
+	if _, err := d.Floor("x"); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError, got %v", err)
+	}
+}
+
+// Test | SortedDict verifies Equal, Copy and conversion to Dict
+func TestSortedDictEqual(t *testing.T) {
+	a, _ := NewSortedDict(1, "a", 2, "b")
+	b, _ := NewSortedDict(2, "b", 1, "a")
+	if !Equal(a, b) || Hash(a) != Hash(b) {
+		t.Error("Expected insertion order not to matter")
+	}
+
+	c := a.Copy()
+	c.Set(1, "z")
+	if Equal(a, c) || a.GetDefault(1, nil) != "a" {
+		t.Error("Expected the copy to be independent")
+	}
+
+	plain, _ := NewDict(3, "c", 1, "a")
+	s, _ := SortedDictFromDict(plain)
+	if Repr(s.ToDict()) != "{1: 'a', 3: 'c'}" {
+		t.Errorf("Unexpected dict: %s", Repr(s.ToDict()))
+	}
+	if filtered := s.Filter(func(k, v interface{}) bool { return k.(int) > 1 }); Repr(filtered) != "SortedDict({3: 'c'})" {
+		t.Errorf("Unexpected filter result: %s", Repr(filtered))
+	}
+}
@@ -0,0 +1,532 @@
+package ezarr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sortedListLoad is the target chunk size of a SortedList. Chunks are split
+// when they grow past twice this size and merged with a neighbour when they
+// shrink below half of it.
+const sortedListLoad = 1000
+
+// SortedList keeps its elements sorted as they are added, like
+// sortedcontainers.SortedList in Python. Elements are ordered with Compare,
+// or by key(element) when a key function is given; equal elements keep
+// their insertion order.
+//
+// Elements are stored in a list of sorted chunks together with the largest
+// key of each chunk and a Fenwick tree of chunk lengths, so that adding,
+// removing, searching and indexing take O(log n) comparisons.
+type SortedList struct {
+	key    func(interface{}) interface{}
+	chunks [][]interface{}
+	keys   [][]interface{}
+	maxes  []interface{}
+	tree   []int
+	length int
+}
+
+// NewSortedList returns a SortedList holding elements. key may be nil.
+func NewSortedList(key func(interface{}) interface{}, elements ...interface{}) (*SortedList, error) {
+	s := &SortedList{key: key}
+	if err := s.Update(&List{Elements: elements}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *SortedList) Add(element interface{}) error {
+	k := s.keyOf(element)
+	if len(s.chunks) == 0 {
+		s.chunks = [][]interface{}{{element}}
+		if s.key != nil {
+			s.keys = [][]interface{}{{k}}
+		}
+		s.maxes = []interface{}{k}
+		s.length = 1
+		s.buildTree()
+		return nil
+	}
+
+	ci, err := bisect(len(s.maxes), s.maxAt, k, true)
+	if err != nil {
+		return err
+	}
+	pos := 0
+	if ci == len(s.chunks) {
+		ci--
+		pos = len(s.chunks[ci])
+		s.maxes[ci] = k
+	} else if pos, err = bisect(len(s.chunks[ci]), s.keyAt(ci), k, true); err != nil {
+		return err
+	}
+
+	s.chunks[ci] = insertAt(s.chunks[ci], pos, element)
+	if s.key != nil {
+		s.keys[ci] = insertAt(s.keys[ci], pos, k)
+	}
+	s.length++
+	s.treeAdd(ci, 1)
+	if len(s.chunks[ci]) > 2*sortedListLoad {
+		s.split(ci)
+	}
+	return nil
+}
+
+// Update adds every element of l. When l is large compared to s, all the
+// elements are sorted together and the chunks rebuilt instead.
+func (s *SortedList) Update(l *List) error {
+	if s.length > 0 && len(l.Elements) < s.length/2 {
+		for _, e := range l.Elements {
+			if err := s.Add(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	all := &List{Elements: append(s.elements(), l.Elements...)}
+	if err := all.SortBy(s.key, false); err != nil {
+		return err
+	}
+	s.build(all.Elements)
+	return nil
+}
+
+// Remove removes the first element equal to element, or returns a
+// ValueError when there is none.
+func (s *SortedList) Remove(element interface{}) error {
+	ci, pos, err := s.find(element)
+	if err != nil {
+		return err
+	}
+	if ci == -1 {
+		return notInList(element)
+	}
+	s.deleteAt(ci, pos)
+	return nil
+}
+
+// Discard removes element if it is present and reports whether it was.
+func (s *SortedList) Discard(element interface{}) bool {
+	ci, pos, err := s.find(element)
+	if err != nil || ci == -1 {
+		return false
+	}
+	s.deleteAt(ci, pos)
+	return true
+}
+
+func (s *SortedList) Contains(element interface{}) bool {
+	ci, _, err := s.find(element)
+	return err == nil && ci != -1
+}
+
+// Index returns the position of the first element equal to element, or a
+// ValueError when there is none.
+func (s *SortedList) Index(element interface{}) (int, error) {
+	ci, pos, err := s.find(element)
+	if err != nil {
+		return -1, err
+	}
+	if ci == -1 {
+		return -1, notInList(element)
+	}
+	return s.prefix(ci) + pos, nil
+}
+
+func (s *SortedList) Count(element interface{}) int {
+	ci, pos, err := s.find(element)
+	if err != nil || ci == -1 {
+		return 0
+	}
+	k := s.keyOf(element)
+	count := 0
+	for ; ci < len(s.chunks); ci, pos = ci+1, 0 {
+		keys := s.chunkKeys(ci)
+		for ; pos < len(keys); pos++ {
+			if c, err := Compare(keys[pos], k); err != nil || c != 0 {
+				return count
+			}
+			if valuesEqual(s.chunks[ci][pos], element) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func (s *SortedList) Get(index int) (interface{}, error) {
+	position, err := s.position(index)
+	if err != nil {
+		return nil, err
+	}
+	ci, pos := s.locate(position)
+	return s.chunks[ci][pos], nil
+}
+
+// Pop removes and returns the element at index; -1 is the last element.
+func (s *SortedList) Pop(index int) (interface{}, error) {
+	if s.length == 0 {
+		return nil, popFromEmpty(index)
+	}
+	position, err := s.position(index)
+	if err != nil {
+		return nil, err
+	}
+	ci, pos := s.locate(position)
+	element := s.chunks[ci][pos]
+	s.deleteAt(ci, pos)
+	return element, nil
+}
+
+// BisectLeft returns the position at which element would be added before
+// any equal elements.
+func (s *SortedList) BisectLeft(element interface{}) (int, error) {
+	return s.bisect(s.keyOf(element), false)
+}
+
+// BisectRight returns the position at which element would be added after
+// any equal elements.
+func (s *SortedList) BisectRight(element interface{}) (int, error) {
+	return s.bisect(s.keyOf(element), true)
+}
+
+// Bisect is the same as BisectRight.
+func (s *SortedList) Bisect(element interface{}) (int, error) {
+	return s.BisectRight(element)
+}
+
+// IRange returns the elements between lo and hi, both included. A nil
+// bound leaves that side of the range open.
+func (s *SortedList) IRange(lo, hi interface{}) (*List, error) {
+	start, end := 0, s.length
+	var err error
+	if lo != nil {
+		if start, err = s.BisectLeft(lo); err != nil {
+			return nil, err
+		}
+	}
+	if hi != nil {
+		if end, err = s.BisectRight(hi); err != nil {
+			return nil, err
+		}
+	}
+	return s.slice(start, end), nil
+}
+
+// Slice returns the elements from start up to end, with the bounds handled
+// like List.Slice.
+func (s *SortedList) Slice(start, end int) *List {
+	start, end = sliceBounds(s.length, start, end)
+	return s.slice(start, end)
+}
+
+func (s *SortedList) Len() int {
+	return s.length
+}
+
+func (s *SortedList) Clear() *SortedList {
+	s.chunks, s.keys, s.maxes, s.tree = nil, nil, nil, nil
+	s.length = 0
+	return s
+}
+
+func (s *SortedList) Copy() *SortedList {
+	c := &SortedList{key: s.key}
+	c.build(s.elements())
+	return c
+}
+
+func (s *SortedList) ToList() *List {
+	return &List{Elements: s.elements()}
+}
+
+func (s *SortedList) String() string {
+	if reprStrings.Load() {
+		return Repr(s)
+	}
+	strElems := make([]string, 0, s.length)
+	for _, chunk := range s.chunks {
+		for _, e := range chunk {
+			strElems = append(strElems, fmt.Sprintf("%v", e))
+		}
+	}
+	return "SortedList([" + strings.Join(strElems, ", ") + "])"
+}
+
+func (s *SortedList) Repr() string {
+	return Repr(s)
+}
+
+func (s *SortedList) keyOf(element interface{}) interface{} {
+	if s.key == nil {
+		return element
+	}
+	return s.key(element)
+}
+
+// chunkKeys returns the sort keys of chunk ci. Without a key function the
+// elements are their own keys and no separate slice is kept.
+func (s *SortedList) chunkKeys(ci int) []interface{} {
+	if s.key == nil {
+		return s.chunks[ci]
+	}
+	return s.keys[ci]
+}
+
+func (s *SortedList) keyAt(ci int) func(int) interface{} {
+	keys := s.chunkKeys(ci)
+	return func(i int) interface{} { return keys[i] }
+}
+
+func (s *SortedList) maxAt(i int) interface{} {
+	return s.maxes[i]
+}
+
+func (s *SortedList) elements() []interface{} {
+	elements := make([]interface{}, 0, s.length)
+	for _, chunk := range s.chunks {
+		elements = append(elements, chunk...)
+	}
+	return elements
+}
+
+// hashElements ignores the key function, so SortedLists holding the same
+// elements are Equal whatever their keys.
+func (s SortedList) hashElements() ([]interface{}, bool) {
+	return s.elements(), true
+}
+
+func (s *SortedList) bisect(k interface{}, right bool) (int, error) {
+	ci, err := bisect(len(s.maxes), s.maxAt, k, right)
+	if err != nil || ci == len(s.chunks) {
+		return s.length, err
+	}
+	pos, err := bisect(len(s.chunks[ci]), s.keyAt(ci), k, right)
+	if err != nil {
+		return 0, err
+	}
+	return s.prefix(ci) + pos, nil
+}
+
+// find returns the chunk and position of the first element equal to
+// element, or -1 when there is none. Elements with an equal key may be
+// spread over several chunks.
+func (s *SortedList) find(element interface{}) (int, int, error) {
+	k := s.keyOf(element)
+	ci, err := bisect(len(s.maxes), s.maxAt, k, false)
+	if err != nil || ci == len(s.chunks) {
+		return -1, -1, err
+	}
+	pos, err := bisect(len(s.chunks[ci]), s.keyAt(ci), k, false)
+	if err != nil {
+		return -1, -1, err
+	}
+
+	for ; ci < len(s.chunks); ci, pos = ci+1, 0 {
+		keys := s.chunkKeys(ci)
+		for ; pos < len(keys); pos++ {
+			c, err := Compare(keys[pos], k)
+			if err != nil {
+				return -1, -1, err
+			}
+			if c != 0 {
+				return -1, -1, nil
+			}
+			if valuesEqual(s.chunks[ci][pos], element) {
+				return ci, pos, nil
+			}
+		}
+	}
+	return -1, -1, nil
+}
+
+func (s *SortedList) position(index int) (int, error) {
+	position := index
+	if position < 0 {
+		position = s.length + position
+	}
+	if position < 0 || position >= s.length {
+		return 0, indexOutOfRange(index)
+	}
+	return position, nil
+}
+
+func (s *SortedList) slice(start, end int) *List {
+	if start >= end {
+		return &List{Elements: []interface{}{}}
+	}
+	elements := make([]interface{}, 0, end-start)
+	ci, pos := s.locate(start)
+	for len(elements) < end-start {
+		n := len(s.chunks[ci]) - pos
+		if rest := end - start - len(elements); n > rest {
+			n = rest
+		}
+		elements = append(elements, s.chunks[ci][pos:pos+n]...)
+		ci, pos = ci+1, 0
+	}
+	return &List{Elements: elements}
+}
+
+// build replaces the contents of s with elements, which must be sorted.
+func (s *SortedList) build(elements []interface{}) {
+	s.Clear()
+	for start := 0; start < len(elements); start += sortedListLoad {
+		end := start + sortedListLoad
+		if end > len(elements) {
+			end = len(elements)
+		}
+		chunk := append([]interface{}{}, elements[start:end]...)
+		s.chunks = append(s.chunks, chunk)
+		if s.key != nil {
+			keys := make([]interface{}, len(chunk))
+			for i, e := range chunk {
+				keys[i] = s.key(e)
+			}
+			s.keys = append(s.keys, keys)
+		}
+		s.maxes = append(s.maxes, s.keyOf(chunk[len(chunk)-1]))
+	}
+	s.length = len(elements)
+	s.buildTree()
+}
+
+func (s *SortedList) deleteAt(ci, pos int) {
+	s.chunks[ci] = removeAt(s.chunks[ci], pos)
+	if s.key != nil {
+		s.keys[ci] = removeAt(s.keys[ci], pos)
+	}
+	s.length--
+	s.treeAdd(ci, -1)
+
+	switch n := len(s.chunks[ci]); {
+	case n == 0:
+		s.chunks = removeChunk(s.chunks, ci)
+		if s.key != nil {
+			s.keys = removeChunk(s.keys, ci)
+		}
+		s.maxes = removeAt(s.maxes, ci)
+		s.buildTree()
+	case n < sortedListLoad/2 && len(s.chunks) > 1:
+		s.maxes[ci] = s.chunkKeys(ci)[n-1]
+		if ci == len(s.chunks)-1 {
+			ci--
+		}
+		s.merge(ci)
+	default:
+		s.maxes[ci] = s.chunkKeys(ci)[n-1]
+	}
+}
+
+// split cuts chunk ci in two halves.
+func (s *SortedList) split(ci int) {
+	half := len(s.chunks[ci]) / 2
+	s.chunks = insertChunk(s.chunks, ci+1, append([]interface{}{}, s.chunks[ci][half:]...))
+	s.chunks[ci] = clearTail(s.chunks[ci], half)
+	if s.key != nil {
+		s.keys = insertChunk(s.keys, ci+1, append([]interface{}{}, s.keys[ci][half:]...))
+		s.keys[ci] = clearTail(s.keys[ci], half)
+	}
+	s.maxes = insertAt(s.maxes, ci+1, s.maxes[ci])
+	s.maxes[ci] = s.chunkKeys(ci)[half-1]
+	s.buildTree()
+}
+
+// merge joins chunks ci and ci+1, splitting the result again if it is too
+// large.
+func (s *SortedList) merge(ci int) {
+	s.chunks[ci] = append(s.chunks[ci], s.chunks[ci+1]...)
+	s.chunks = removeChunk(s.chunks, ci+1)
+	if s.key != nil {
+		s.keys[ci] = append(s.keys[ci], s.keys[ci+1]...)
+		s.keys = removeChunk(s.keys, ci+1)
+	}
+	s.maxes = removeAt(s.maxes, ci)
+	if len(s.chunks[ci]) > 2*sortedListLoad {
+		s.split(ci)
+		return
+	}
+	s.buildTree()
+}
+
+// The Fenwick tree holds the chunk lengths, so the number of elements
+// before a chunk and the chunk holding a position are found in O(log n).
+
+func (s *SortedList) buildTree() {
+	s.tree = make([]int, len(s.chunks)+1)
+	for i, chunk := range s.chunks {
+		s.tree[i+1] += len(chunk)
+		if parent := i + 1 + (i+1)&-(i+1); parent < len(s.tree) {
+			s.tree[parent] += s.tree[i+1]
+		}
+	}
+}
+
+func (s *SortedList) treeAdd(ci, delta int) {
+	for i := ci + 1; i < len(s.tree); i += i & -i {
+		s.tree[i] += delta
+	}
+}
+
+// prefix returns the number of elements in the chunks before ci.
+func (s *SortedList) prefix(ci int) int {
+	total := 0
+	for i := ci; i > 0; i -= i & -i {
+		total += s.tree[i]
+	}
+	return total
+}
+
+// locate returns the chunk and the position within it of the element at
+// position, which must be in range.
+func (s *SortedList) locate(position int) (int, int) {
+	ci := 0
+	step := 1
+	for step*2 < len(s.tree) {
+		step *= 2
+	}
+	for ; step > 0; step /= 2 {
+		if next := ci + step; next < len(s.tree) && s.tree[next] <= position {
+			ci = next
+			position -= s.tree[next]
+		}
+	}
+	return ci, position
+}
+
+func insertAt(s []interface{}, i int, v interface{}) []interface{} {
+	s = append(s, nil)
+	copy(s[i+1:], s[i:])
+	s[i] = v
+	return s
+}
+
+func removeAt(s []interface{}, i int) []interface{} {
+	copy(s[i:], s[i+1:])
+	s[len(s)-1] = nil
+	return s[:len(s)-1]
+}
+
+// clearTail truncates s to n elements, dropping references to the rest.
+func clearTail(s []interface{}, n int) []interface{} {
+	for i := n; i < len(s); i++ {
+		s[i] = nil
+	}
+	return s[:n]
+}
+
+func insertChunk(s [][]interface{}, i int, chunk []interface{}) [][]interface{} {
+	s = append(s, nil)
+	copy(s[i+1:], s[i:])
+	s[i] = chunk
+	return s
+}
+
+func removeChunk(s [][]interface{}, i int) [][]interface{} {
+	copy(s[i:], s[i+1:])
+	s[len(s)-1] = nil
+	return s[:len(s)-1]
+}
@@ -0,0 +1,192 @@
+package ezarr
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// Test | SortedList verifies basic operations against Python results
+func TestSortedList(t *testing.T) {
+	s, err := NewSortedList(nil, 5, 1, 4, 1, 3)
+	if err != nil {
+		t.Fatalf("NewSortedList returned error: %v", err)
+	}
+	s.Add(2)
+	if Repr(s) != "SortedList([1, 1, 2, 3, 4, 5])" || s.Len() != 6 {
+		t.Errorf("Unexpected contents: %s", Repr(s))
+	}
+
+	if v, err := s.Get(-1); err != nil || v != 5 {
+		t.Errorf("Expected 5 at -1, got %v, error: %v", v, err)
+	}
+	if _, err := s.Get(6); !errors.Is(err, ErrIndex) {
+		t.Errorf("Expected IndexError, got %v", err)
+	}
+	if i, err := s.Index(3); err != nil || i != 3 {
+		t.Errorf("Expected index 3, got %d, error: %v", i, err)
+	}
+	if s.Count(1) != 2 || !s.Contains(4) || s.Contains(6) {
+		t.Error("Unexpected Count or Contains result")
+	}
+
+	if err := s.Remove(1.0); err != nil || s.Count(1) != 1 {
+		t.Errorf("Expected Remove to remove one equal element, error: %v", err)
+	}
+	if err := s.Remove(7); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError removing a missing element, got %v", err)
+	}
+	if s.Discard(7) || !s.Discard(5) {
+		t.Error("Unexpected Discard result")
+	}
+	if v, err := s.Pop(0); err != nil || v != 1 || Repr(s) != "SortedList([2, 3, 4])" {
+		t.Errorf("Expected to pop 1, got %v and %s, error: %v", v, Repr(s), err)
+	}
+
+	if err := s.Add("a"); !errors.Is(err, ErrType) || s.Len() != 3 {
+		t.Errorf("Expected TypeError and no change, got %v", err)
+	}
+	if _, err := s.Clear().Pop(-1); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected empty IndexError, got %v", err)
+	}
+}
+
+// Test | SortedList verifies Bisect and IRange
+func TestSortedListRange(t *testing.T) {
+	s, _ := NewSortedList(nil, 10, 20, 20, 30, 40)
+
+	if i, _ := s.BisectLeft(20); i != 1 {
+		t.Errorf("Expected BisectLeft 1, got %d", i)
+	}
+	if i, _ := s.Bisect(20); i != 3 {
+		t.Errorf("Expected Bisect 3, got %d", i)
+	}
+	if i, _ := s.BisectRight(50); i != 5 {
+		t.Errorf("Expected BisectRight 5, got %d", i)
+	}
+
+	cases := []struct {
+		lo, hi   interface{}
+		expected *List
+	}{
+		{20, 30, New(20, 20, 30)},
+		{15, 35, New(20, 20, 30)},
+		{nil, 20, New(10, 20, 20)},
+		{30, nil, New(30, 40)},
+		{41, 50, New()},
+	}
+	for _, c := range cases {
+		got, err := s.IRange(c.lo, c.hi)
+		if err != nil || !Equal(got, c.expected) {
+			t.Errorf("IRange(%v, %v): expected %v, got %v, error: %v", c.lo, c.hi, c.expected, got, err)
+		}
+	}
+
+	if got := s.Slice(1, -1); !Equal(got, New(20, 20, 30)) {
+		t.Errorf("Expected [20, 20, 30], got %v", got)
+	}
+}
+
+// Test | SortedList verifies a key function and stable order of equal keys
+func TestSortedListKey(t *testing.T) {
+	byLen := func(e interface{}) interface{} { return len(e.(string)) }
+	s, _ := NewSortedList(byLen, "ccc", "a", "bb", "b")
+	s.Add("dd")
+
+	if Repr(s) != "SortedList(['a', 'b', 'bb', 'dd', 'ccc'])" {
+		t.Errorf("Unexpected order: %s", Repr(s))
+	}
+	if i, _ := s.Index("dd"); i != 3 {
+		t.Errorf("Expected to find dd among equal keys at 3, got %d", i)
+	}
+	if s.Contains("ee") {
+		t.Error("Expected an element with an equal key not to be contained")
+	}
+	if got, _ := s.IRange("xx", "yy"); !Equal(got, New("bb", "dd")) {
+		t.Errorf("Expected IRange to compare by key, got %v", got)
+	}
+}
+
+// Test | SortedList verifies a large random workload against a sorted slice
+func TestSortedListRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	s, _ := NewSortedList(nil)
+	var expected []int
+
+	for i := 0; i < 20000; i++ {
+		switch {
+		case len(expected) > 0 && rng.Intn(3) == 0:
+			v := expected[rng.Intn(len(expected))]
+			if err := s.Remove(v); err != nil {
+				t.Fatalf("Remove(%d) returned error: %v", v, err)
+			}
+			j := sort.SearchInts(expected, v)
+			expected = append(expected[:j], expected[j+1:]...)
+		default:
+			v := rng.Intn(5000)
+			s.Add(v)
+			j := sort.SearchInts(expected, v)
+			expected = append(expected[:j], append([]int{v}, expected[j:]...)...)
+		}
+	}
+
+	if s.Len() != len(expected) {
+		t.Fatalf("Expected length %d, got %d", len(expected), s.Len())
+	}
+	for i, v := range s.ToList().Elements {
+		if v != expected[i] {
+			t.Fatalf("Expected %d at %d, got %v", expected[i], i, v)
+		}
+	}
+	for i := 0; i < len(expected); i += 97 {
+		if v, _ := s.Get(i); v != expected[i] {
+			t.Fatalf("Expected %d at %d, got %v", expected[i], i, v)
+		}
+		if j, _ := s.BisectLeft(expected[i]); j != sort.SearchInts(expected, expected[i]) {
+			t.Fatalf("Unexpected BisectLeft for %d: %d", expected[i], j)
+		}
+	}
+	got, _ := s.IRange(1000, 2000)
+	lo, hi := sort.SearchInts(expected, 1000), sort.SearchInts(expected, 2001)
+	if got.Len() != hi-lo {
+		t.Errorf("Expected %d elements in range, got %d", hi-lo, got.Len())
+	}
+
+	for s.Len() > 0 {
+		s.Pop(rng.Intn(s.Len()))
+	}
+	if len(s.chunks) != 0 {
+		t.Errorf("Expected no chunks left, got %d", len(s.chunks))
+	}
+}
+
+// Test | SortedList verifies Equal and Copy regardless of chunk layout
+func TestSortedListEqual(t *testing.T) {
+	elements := make([]interface{}, 3000)
+	for i := range elements {
+		elements[i] = i
+	}
+	built, _ := NewSortedList(nil, elements...)
+	added, _ := NewSortedList(nil)
+	for i := len(elements) - 1; i >= 0; i-- {
+		added.Add(i)
+	}
+
+	if !Equal(built, added) || Hash(built) != Hash(added) {
+		t.Error("Expected lists with the same elements to be equal")
+	}
+	c := built.Copy()
+	c.Add(1)
+	if Equal(built, c) || built.Len() != 3000 {
+		t.Error("Expected the copy to be independent")
+	}
+}
+
+func BenchmarkSortedListAdd(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	s, _ := NewSortedList(nil)
+	for i := 0; i < b.N; i++ {
+		s.Add(rng.Int())
+	}
+}
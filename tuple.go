@@ -0,0 +1,176 @@
+package ezarr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Tuple is an immutable sequence. It is a plain value that compares with
+// Equal and hashes by its elements, so it can be used as a Dict key.
+// Tuples built by a TupleType also carry field names, which Equal and Hash
+// ignore, as Python does for namedtuples.
+type Tuple struct {
+	elements []interface{}
+	typ      *TupleType
+}
+
+// TupleType describes a named tuple, like Python's collections.namedtuple.
+type TupleType struct {
+	name   string
+	fields []string
+}
+
+func NewTuple(elements ...interface{}) Tuple {
+	return Tuple{elements: append([]interface{}{}, elements...)}
+}
+
+func TupleFromList(l *List) Tuple {
+	return NewTuple(l.Elements...)
+}
+
+func NewTupleType(name string, fields ...string) (*TupleType, error) {
+	if name == "" {
+		return nil, &ValueError{Value: name, Msg: "type name must not be empty"}
+	}
+	for i, field := range fields {
+		if field == "" {
+			return nil, &ValueError{Value: field, Msg: "field names must not be empty"}
+		}
+		for _, other := range fields[:i] {
+			if other == field {
+				return nil, &ValueError{Value: field, Msg: fmt.Sprintf("encountered duplicate field name: '%s'", field)}
+			}
+		}
+	}
+	return &TupleType{name: name, fields: append([]string{}, fields...)}, nil
+}
+
+func (t *TupleType) New(values ...interface{}) (Tuple, error) {
+	if len(values) != len(t.fields) {
+		return Tuple{}, &TypeError{
+			Value: values,
+			Msg:   fmt.Sprintf("%s expects %d arguments, got %d", t.name, len(t.fields), len(values)),
+		}
+	}
+	tuple := NewTuple(values...)
+	tuple.typ = t
+	return tuple, nil
+}
+
+func (t *TupleType) Name() string {
+	return t.name
+}
+
+func (t *TupleType) Fields() []string {
+	return append([]string{}, t.fields...)
+}
+
+func (t Tuple) Type() *TupleType {
+	return t.typ
+}
+
+func (t Tuple) Len() int {
+	return len(t.elements)
+}
+
+func (t Tuple) Get(index int) (interface{}, error) {
+	position := index
+	if position < 0 {
+		position = len(t.elements) + position
+	}
+	if position < 0 || position >= len(t.elements) {
+		return nil, indexOutOfRange(index)
+	}
+	return t.elements[position], nil
+}
+
+func (t Tuple) Field(name string) (interface{}, error) {
+	if t.typ != nil {
+		for i, field := range t.typ.fields {
+			if field == name {
+				return t.elements[i], nil
+			}
+		}
+	}
+	return nil, &KeyError{Key: name, Msg: fmt.Sprintf("%s has no field '%s'", pyTypeName(t), name)}
+}
+
+func (t Tuple) Slice(start, end int) Tuple {
+	start, end = sliceBounds(len(t.elements), start, end)
+	return NewTuple(t.elements[start:end]...)
+}
+
+func (t Tuple) SliceStep(start, stop, step int) (Tuple, error) {
+	start, _, step, n, err := sliceIndices(len(t.elements), start, stop, step)
+	if err != nil {
+		return Tuple{}, err
+	}
+
+	elements := make([]interface{}, n)
+	for i := range elements {
+		elements[i] = t.elements[start+i*step]
+	}
+	return Tuple{elements: elements}, nil
+}
+
+func (t Tuple) Index(element interface{}) int {
+	for i, e := range t.elements {
+		if Equal(e, element) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t Tuple) Count(element interface{}) int {
+	count := 0
+	for _, e := range t.elements {
+		if Equal(e, element) {
+			count++
+		}
+	}
+	return count
+}
+
+func (t Tuple) ToList() *List {
+	return &List{Elements: append([]interface{}{}, t.elements...)}
+}
+
+// AsDict maps the field names of a named tuple to its values.
+func (t Tuple) AsDict() *Dict {
+	d := &Dict{Keys: []interface{}{}, Values: []interface{}{}}
+	if t.typ != nil {
+		for i, field := range t.typ.fields {
+			d.Set(field, t.elements[i])
+		}
+	}
+	return d
+}
+
+func (t Tuple) String() string {
+	if reprStrings.Load() {
+		return Repr(t)
+	}
+	strElems := make([]string, len(t.elements))
+	for i, e := range t.elements {
+		strElems[i] = fmt.Sprintf("%v", e)
+		if t.typ != nil {
+			strElems[i] = t.typ.fields[i] + "=" + strElems[i]
+		}
+	}
+	if t.typ != nil {
+		return t.typ.name + "(" + strings.Join(strElems, ", ") + ")"
+	}
+	if len(strElems) == 1 {
+		return "(" + strElems[0] + ",)"
+	}
+	return "(" + strings.Join(strElems, ", ") + ")"
+}
+
+func (t Tuple) Repr() string {
+	return Repr(t)
+}
+
+func (t Tuple) hashElements() ([]interface{}, bool) {
+	return t.elements, true
+}
@@ -0,0 +1,157 @@
+package ezarr
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// Test | Tuple verifies indexing, slicing, Count and Index
+func TestTuple(t *testing.T) {
+	tuple := NewTuple(1, "a", New(2), "a")
+
+	if tuple.Len() != 4 {
+		t.Errorf("Expected length 4, got %d", tuple.Len())
+	}
+	if val, err := tuple.Get(-1); err != nil || val != "a" {
+		t.Errorf("Expected 'a' at index -1, got %v, error: %v", val, err)
+	}
+	if _, err := tuple.Get(4); !errors.Is(err, ErrIndex) {
+		t.Errorf("Expected IndexError for index 4, got %v", err)
+	}
+
+	if !Equal(tuple.Slice(1, -1), NewTuple("a", New(2))) {
+		t.Errorf("Expected ('a', [2]), got %v", tuple.Slice(1, -1))
+	}
+	reversed, err := tuple.SliceStep(Omit, Omit, -2)
+	if err != nil || !Equal(reversed, NewTuple("a", "a")) {
+		t.Errorf("Expected ('a', 'a'), got %v, error: %v", reversed, err)
+	}
+	if _, err := tuple.SliceStep(0, 1, 0); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError for a zero step, got %v", err)
+	}
+
+	if tuple.Count("a") != 2 || tuple.Index(New(2)) != 2 || tuple.Index("z") != -1 {
+		t.Errorf("Unexpected Count or Index result for %v", tuple)
+	}
+
+	list := tuple.ToList()
+	list.Elements[0] = 99
+	if val, _ := tuple.Get(0); val != 1 {
+		t.Error("Expected ToList to return a copy")
+	}
+	source := New(1, 2)
+	fromList := TupleFromList(source)
+	source.Elements[0] = 99
+	if val, _ := fromList.Get(0); val != 1 {
+		t.Error("Expected TupleFromList to copy the elements")
+	}
+}
+
+// Test | Tuple verifies use as a Dict key and Python ordering
+func TestTupleKeysAndOrder(t *testing.T) {
+	dict := &Dict{}
+	dict.Set(NewTuple(1, 2), "a")
+	dict.Set(NewTuple(1, 2), "b")
+	dict.Set(NewTuple(2, 1), "c")
+	if dict.Len() != 2 || dict.GetDefault(NewTuple(1, 2), nil) != "b" {
+		t.Errorf("Expected equal tuples to share a key, got %v", dict)
+	}
+	if Hash(NewTuple()) != Hash(Tuple{}) || !Equal(NewTuple(), Tuple{}) {
+		t.Error("Expected the zero Tuple to equal an empty one")
+	}
+
+	cases := []struct {
+		a, b     interface{}
+		expected int
+	}{
+		{NewTuple(1, 2), NewTuple(1, 3), -1},
+		{NewTuple(1, 2), NewTuple(1), 1},
+		{NewTuple(1, 2.0), NewTuple(1.0, 2), 0},
+		{NewTuple(1, 2), []interface{}{1, 2}, 0},
+	}
+	for _, c := range cases {
+		got, err := Compare(c.a, c.b)
+		if err != nil || got != c.expected {
+			t.Errorf("Compare(%v, %v) = %d, error: %v, expected %d", c.a, c.b, got, err, c.expected)
+		}
+	}
+
+	if _, err := Compare(NewTuple(1), New(1)); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError comparing tuple and list, got %v", err)
+	}
+
+	list := New(NewTuple(2, "b"), NewTuple(1, "z"), NewTuple(2, "a"))
+	if err := list.Sort(); err != nil || Repr(list) != "[(1, 'z'), (2, 'a'), (2, 'b')]" {
+		t.Errorf("Expected tuples to sort lexicographically, got %s, error: %v", Repr(list), err)
+	}
+}
+
+// Test | TupleType verifies named fields, repr and validation
+func TestTupleType(t *testing.T) {
+	point, err := NewTupleType("Point", "x", "y")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	p, err := point.New(1, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if x, err := p.Field("x"); err != nil || x != 1 {
+		t.Errorf("Expected x = 1, got %v, error: %v", x, err)
+	}
+	if _, err := p.Field("z"); !errors.Is(err, ErrKey) {
+		t.Errorf("Expected KeyError for an unknown field, got %v", err)
+	}
+	if p.Type() != point || point.Name() != "Point" || len(point.Fields()) != 2 {
+		t.Errorf("Unexpected type information for %v", p)
+	}
+
+	if Repr(p) != "Point(x=1, y=2)" || p.String() != "Point(x=1, y=2)" {
+		t.Errorf("Expected Point(x=1, y=2), got %s", Repr(p))
+	}
+	if Repr(p.AsDict()) != "{'x': 1, 'y': 2}" {
+		t.Errorf("Expected {'x': 1, 'y': 2}, got %s", Repr(p.AsDict()))
+	}
+
+	if !Equal(p, NewTuple(1, 2)) || Hash(p) != Hash(NewTuple(1, 2)) {
+		t.Error("Expected a named tuple to equal and hash like a plain tuple")
+	}
+	d := &Dict{}
+	d.Set(NewTuple(1, 2), "origin")
+	if val, err := d.Get(p); err != nil || val != "origin" {
+		t.Errorf("Expected a named tuple to find a plain tuple key, got %v, error: %v", val, err)
+	}
+	if c, err := Compare(p, NewTuple(1, 2)); err != nil || c != 0 {
+		t.Errorf("Expected a named tuple to order like a plain tuple, got %d, error: %v", c, err)
+	}
+	if Repr(p.Slice(0, 1)) != "(1,)" {
+		t.Errorf("Expected slicing to give a plain tuple, got %s", Repr(p.Slice(0, 1)))
+	}
+
+	if _, err := point.New(1); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError for a wrong argument count, got %v", err)
+	}
+	if _, err := NewTupleType("Bad", "a", "a"); !errors.Is(err, ErrValue) {
+		t.Errorf("Expected ValueError for duplicate fields, got %v", err)
+	}
+}
+
+// Test | Dict verifies GetItemTuples and JSON encoding of tuples
+func TestDictItemTuples(t *testing.T) {
+	dict, _ := NewDict("a", 1, "b", 2)
+	items := dict.GetItemTuples()
+	if Repr(items) != "[('a', 1), ('b', 2)]" {
+		t.Errorf("Expected [('a', 1), ('b', 2)], got %s", Repr(items))
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil || string(data) != `[["a",1],["b",2]]` {
+		t.Errorf("Expected tuples to encode as arrays, got %s, error: %v", data, err)
+	}
+
+	if NewTuple(1).String() != "(1,)" || NewTuple().String() != "()" {
+		t.Errorf("Unexpected String output: %s, %s", NewTuple(1), NewTuple())
+	}
+}
@@ -0,0 +1,260 @@
+package ezarr
+
+import (
+	"fmt"
+	"strings"
+)
+
+type TypedItem[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// TypedDict keeps entries in insertion order in parallel slices and maps
+// each key to its slot. Deleted slots are left as holes and compacted once
+// they make up half of the slots, so deletion stays O(1) amortized.
+type TypedDict[K comparable, V any] struct {
+	keys    []K
+	values  []V
+	live    []bool
+	index   map[K]int
+	deleted int
+}
+
+func NewTypedDict[K comparable, V any]() *TypedDict[K, V] {
+	return &TypedDict[K, V]{index: map[K]int{}}
+}
+
+func TypedDictFrom[K comparable, V any](d *Dict) (*TypedDict[K, V], error) {
+	result := NewTypedDict[K, V]()
+	for i, k := range d.Keys {
+		key, ok := convert[K](k)
+		if !ok {
+			return nil, &TypeError{
+				Value: k,
+				Msg:   fmt.Sprintf("key %v is not of type %s", k, typeName[K]()),
+			}
+		}
+		value, ok := convert[V](d.Values[i])
+		if !ok {
+			return nil, &TypeError{
+				Value: d.Values[i],
+				Msg:   fmt.Sprintf("value %v for key %v is not of type %s", d.Values[i], k, typeName[V]()),
+			}
+		}
+		result.Set(key, value)
+	}
+	return result, nil
+}
+
+func (d *TypedDict[K, V]) ToDict() *Dict {
+	result := &Dict{
+		Keys:   make([]interface{}, 0, d.Len()),
+		Values: make([]interface{}, 0, d.Len()),
+	}
+	for i, key := range d.keys {
+		if d.live[i] {
+			result.Keys = append(result.Keys, key)
+			result.Values = append(result.Values, d.values[i])
+		}
+	}
+	result.index.rebuild(result.Keys)
+	return result
+}
+
+func (d *TypedDict[K, V]) Get(key K) (V, error) {
+	if i, ok := d.index[key]; ok {
+		return d.values[i], nil
+	}
+	var zero V
+	return zero, keyNotFound(key)
+}
+
+func (d *TypedDict[K, V]) GetDefault(key K, defaultValue V) V {
+	if i, ok := d.index[key]; ok {
+		return d.values[i]
+	}
+	return defaultValue
+}
+
+func (d *TypedDict[K, V]) Set(key K, value V) *TypedDict[K, V] {
+	if i, ok := d.index[key]; ok {
+		d.values[i] = value
+		return d
+	}
+	if d.index == nil {
+		d.index = map[K]int{}
+	}
+	d.index[key] = len(d.keys)
+	d.keys = append(d.keys, key)
+	d.values = append(d.values, value)
+	d.live = append(d.live, true)
+	return d
+}
+
+func (d *TypedDict[K, V]) Delete(key K) error {
+	if _, err := d.Pop(key); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *TypedDict[K, V]) Contains(key K) bool {
+	_, ok := d.index[key]
+	return ok
+}
+
+func (d *TypedDict[K, V]) Len() int {
+	return len(d.index)
+}
+
+func (d *TypedDict[K, V]) Clear() *TypedDict[K, V] {
+	*d = TypedDict[K, V]{index: map[K]int{}}
+	return d
+}
+
+func (d *TypedDict[K, V]) GetKeys() *TypedList[K] {
+	keys := make([]K, 0, d.Len())
+	for i, key := range d.keys {
+		if d.live[i] {
+			keys = append(keys, key)
+		}
+	}
+	return &TypedList[K]{Elements: keys}
+}
+
+func (d *TypedDict[K, V]) GetValues() *TypedList[V] {
+	values := make([]V, 0, d.Len())
+	for i, value := range d.values {
+		if d.live[i] {
+			values = append(values, value)
+		}
+	}
+	return &TypedList[V]{Elements: values}
+}
+
+func (d *TypedDict[K, V]) GetItems() *TypedList[TypedItem[K, V]] {
+	items := make([]TypedItem[K, V], 0, d.Len())
+	for i, key := range d.keys {
+		if d.live[i] {
+			items = append(items, TypedItem[K, V]{Key: key, Value: d.values[i]})
+		}
+	}
+	return &TypedList[TypedItem[K, V]]{Elements: items}
+}
+
+func (d *TypedDict[K, V]) Update(other *TypedDict[K, V]) *TypedDict[K, V] {
+	for i, key := range other.keys {
+		if other.live[i] {
+			d.Set(key, other.values[i])
+		}
+	}
+	return d
+}
+
+func (d *TypedDict[K, V]) Merge(other *TypedDict[K, V]) *TypedDict[K, V] {
+	return d.Copy().Update(other)
+}
+
+func (d *TypedDict[K, V]) Copy() *TypedDict[K, V] {
+	result := NewTypedDict[K, V]()
+	return result.Update(d)
+}
+
+func (d *TypedDict[K, V]) Pop(key K) (V, error) {
+	i, ok := d.index[key]
+	if !ok {
+		var zero V
+		return zero, keyNotFound(key)
+	}
+
+	value := d.values[i]
+	d.removeAt(i)
+	return value, nil
+}
+
+func (d *TypedDict[K, V]) PopItem() (K, V, error) {
+	if d.Len() == 0 {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, dictIsEmpty()
+	}
+
+	lastIndex := len(d.keys) - 1
+	key := d.keys[lastIndex]
+	value := d.values[lastIndex]
+	d.removeAt(lastIndex)
+	return key, value, nil
+}
+
+func (d *TypedDict[K, V]) Filter(filterFunc func(key K, value V) bool) *TypedDict[K, V] {
+	result := NewTypedDict[K, V]()
+	for i, key := range d.keys {
+		if d.live[i] && filterFunc(key, d.values[i]) {
+			result.Set(key, d.values[i])
+		}
+	}
+	return result
+}
+
+func (d *TypedDict[K, V]) String() string {
+	if reprStrings.Load() {
+		return Repr(d)
+	}
+	pairs := make([]string, 0, d.Len())
+	for i, key := range d.keys {
+		if d.live[i] {
+			pairs = append(pairs, fmt.Sprintf("%v: %v", key, d.values[i]))
+		}
+	}
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+func (d *TypedDict[K, V]) removeAt(i int) {
+	var zeroK K
+	var zeroV V
+
+	delete(d.index, d.keys[i])
+	d.keys[i] = zeroK
+	d.values[i] = zeroV
+	d.live[i] = false
+	d.deleted++
+
+	last := len(d.keys)
+	for last > 0 && !d.live[last-1] {
+		last--
+		d.deleted--
+	}
+	d.keys = d.keys[:last]
+	d.values = d.values[:last]
+	d.live = d.live[:last]
+
+	if d.deleted > 8 && d.deleted*2 > len(d.keys) {
+		d.compact()
+	}
+}
+
+func (d *TypedDict[K, V]) compact() {
+	n := 0
+	for i, key := range d.keys {
+		if !d.live[i] {
+			continue
+		}
+		d.keys[n] = key
+		d.values[n] = d.values[i]
+		d.live[n] = true
+		d.index[key] = n
+		n++
+	}
+
+	var zeroK K
+	var zeroV V
+	for i := n; i < len(d.keys); i++ {
+		d.keys[i] = zeroK
+		d.values[i] = zeroV
+	}
+	d.keys = d.keys[:n]
+	d.values = d.values[:n]
+	d.live = d.live[:n]
+	d.deleted = 0
+}
@@ -0,0 +1,238 @@
+package ezarr
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// Test | TypedDict Get, Set and GetDefault verify basic key-value access
+func TestTypedDictGetSet(t *testing.T) {
+	dict := NewTypedDict[string, int]()
+	dict.Set("a", 1).Set("b", 2).Set("a", 3)
+
+	if dict.Len() != 2 {
+		t.Errorf("Expected length 2, got %d", dict.Len())
+	}
+
+	val, err := dict.Get("a")
+	if err != nil || val != 3 {
+		t.Errorf("Expected value 3 for key 'a', got %v, error: %v", val, err)
+	}
+
+	_, err = dict.Get("missing")
+	if err == nil {
+		t.Error("Expected error for nonexistent key, got nil")
+	}
+
+	if val := dict.GetDefault("missing", 42); val != 42 {
+		t.Errorf("Expected default value 42, got %v", val)
+	}
+
+	var zero TypedDict[string, int]
+	zero.Set("x", 1)
+	if !zero.Contains("x") {
+		t.Error("Expected zero-value TypedDict to be usable")
+	}
+}
+
+// Test | TypedDict verifies that insertion order survives updates and deletions
+func TestTypedDictOrder(t *testing.T) {
+	dict := NewTypedDict[int, int]()
+	for i := 0; i < 100; i++ {
+		dict.Set(i, i*i)
+	}
+	for i := 0; i < 100; i += 3 {
+		if err := dict.Delete(i); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	dict.Set(0, -1)
+	dict.Set(1, -1)
+
+	var expected []int
+	for i := 0; i < 100; i++ {
+		if i%3 != 0 {
+			expected = append(expected, i)
+		}
+	}
+	expected = append(expected, 0)
+
+	if keys := dict.GetKeys().Elements; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected keys %v, got %v", expected, keys)
+	}
+
+	for _, key := range expected {
+		if !dict.Contains(key) {
+			t.Errorf("Expected key %d to be present", key)
+		}
+	}
+	if dict.Contains(3) {
+		t.Error("Expected key 3 to be deleted")
+	}
+
+	if err := dict.Delete(3); err == nil {
+		t.Error("Expected error when deleting nonexistent key, got nil")
+	}
+}
+
+// Test | TypedDict Pop and PopItem verify removing entries
+func TestTypedDictPop(t *testing.T) {
+	dict := NewTypedDict[string, int]()
+	dict.Set("a", 1).Set("b", 2).Set("c", 3)
+
+	val, err := dict.Pop("b")
+	if err != nil || val != 2 {
+		t.Errorf("Expected Pop('b') to return 2, got %v, error: %v", val, err)
+	}
+
+	key, val, err := dict.PopItem()
+	if err != nil || key != "c" || val != 3 {
+		t.Errorf("Expected PopItem to return c: 3, got %v: %v, error: %v", key, val, err)
+	}
+
+	key, _, _ = dict.PopItem()
+	if key != "a" {
+		t.Errorf("Expected PopItem to return 'a', got %v", key)
+	}
+
+	_, _, err = dict.PopItem()
+	if err == nil {
+		t.Error("Expected error for empty dictionary, got nil")
+	}
+}
+
+// Test | TypedDict collection methods verify keys, values, items and string output
+func TestTypedDictCollections(t *testing.T) {
+	dict := NewTypedDict[string, int]()
+	dict.Set("a", 1).Set("b", 2)
+
+	if values := dict.GetValues().Elements; !reflect.DeepEqual(values, []int{1, 2}) {
+		t.Errorf("Expected values [1 2], got %v", values)
+	}
+
+	items := dict.GetItems().Elements
+	expected := []TypedItem[string, int]{{"a", 1}, {"b", 2}}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("Expected items %v, got %v", expected, items)
+	}
+
+	if str := dict.String(); str != "{a: 1, b: 2}" {
+		t.Errorf("Expected string representation '{a: 1, b: 2}', got '%s'", str)
+	}
+
+	other := NewTypedDict[string, int]()
+	other.Set("b", 20).Set("c", 30)
+
+	merged := dict.Merge(other)
+	if merged.Len() != 3 || merged.GetDefault("b", 0) != 20 {
+		t.Errorf("Expected merged dict {a: 1, b: 20, c: 30}, got %v", merged)
+	}
+	if dict.GetDefault("b", 0) != 2 {
+		t.Error("Original dict should be unchanged by Merge")
+	}
+
+	even := merged.Filter(func(key string, value int) bool { return value%2 == 0 })
+	if even.Len() != 2 || even.Contains("a") {
+		t.Errorf("Expected filtered dict {b: 20, c: 30}, got %v", even)
+	}
+
+	dict.Clear()
+	if dict.Len() != 0 || dict.Contains("a") {
+		t.Errorf("Expected empty dict after Clear, got %v", dict)
+	}
+}
+
+// Test | TypedDictFrom and ToDict verify converting between typed and untyped dicts
+func TestTypedDictConversion(t *testing.T) {
+	dict, _ := NewDict("a", 1, "b", 2)
+
+	typed, err := TypedDictFrom[string, int](dict)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if typed.GetDefault("b", 0) != 2 {
+		t.Errorf("Expected value 2 for key 'b', got %v", typed.GetDefault("b", 0))
+	}
+
+	back := typed.ToDict()
+	if !reflect.DeepEqual(back.Keys, dict.Keys) || !reflect.DeepEqual(back.Values, dict.Values) {
+		t.Errorf("Expected %v, got %v", dict, back)
+	}
+
+	mixed, _ := NewDict("a", 1, 2, 2)
+	if _, err := TypedDictFrom[string, int](mixed); err == nil {
+		t.Error("Expected error when converting dict with mismatched key types, got nil")
+	}
+
+	nullable, _ := NewDict("a", &point{1, 2}, "b", nil)
+	pointers, err := TypedDictFrom[string, *point](nullable)
+	if err != nil {
+		t.Fatalf("Expected nil to convert to a nil pointer, got error: %v", err)
+	}
+	if v, err := pointers.Get("b"); err != nil || v != nil {
+		t.Errorf("Expected a nil pointer, got %v, error: %v", v, err)
+	}
+	if _, err := TypedDictFrom[string, int](nullable); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError converting values to int, got %v", err)
+	}
+}
+
+func benchmarkKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = "key" + strconv.Itoa(i)
+	}
+	return keys
+}
+
+func BenchmarkTypedDictGet(b *testing.B) {
+	keys := benchmarkKeys(50000)
+	dict := NewTypedDict[string, int]()
+	for i, key := range keys {
+		dict.Set(key, i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dict.Get(keys[i%len(keys)])
+	}
+}
+
+func BenchmarkDictGet(b *testing.B) {
+	keys := benchmarkKeys(50000)
+	dict := &Dict{}
+	for i, key := range keys {
+		dict.Set(key, i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dict.Get(keys[i%len(keys)])
+	}
+}
+
+func BenchmarkTypedDictSet(b *testing.B) {
+	keys := benchmarkKeys(5000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dict := NewTypedDict[string, int]()
+		for j, key := range keys {
+			dict.Set(key, j)
+		}
+	}
+}
+
+func BenchmarkDictSet(b *testing.B) {
+	keys := benchmarkKeys(5000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dict := &Dict{}
+		for j, key := range keys {
+			dict.Set(key, j)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package ezarr
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type TypedList[T any] struct {
+	Elements []T
+}
+
+func NewTypedList[T any](elements ...T) *TypedList[T] {
+	return &TypedList[T]{Elements: elements}
+}
+
+func TypedListFrom[T any](l *List) (*TypedList[T], error) {
+	elements := make([]T, len(l.Elements))
+	for i, e := range l.Elements {
+		v, ok := convert[T](e)
+		if !ok {
+			return nil, &TypeError{
+				Value: e,
+				Msg:   fmt.Sprintf("element %v at index %d is not of type %s", e, i, typeName[T]()),
+			}
+		}
+		elements[i] = v
+	}
+	return &TypedList[T]{Elements: elements}, nil
+}
+
+func (l *TypedList[T]) ToList() *List {
+	elements := make([]interface{}, len(l.Elements))
+	for i, e := range l.Elements {
+		elements[i] = e
+	}
+	return &List{Elements: elements}
+}
+
+func (l *TypedList[T]) Append(element T) *TypedList[T] {
+	l.Elements = append(l.Elements, element)
+	return l
+}
+
+func (l *TypedList[T]) Extend(other *TypedList[T]) *TypedList[T] {
+	l.Elements = append(l.Elements, other.Elements...)
+	return l
+}
+
+func (l *TypedList[T]) Insert(index int, element T) *TypedList[T] {
+	index = clampIndex(len(l.Elements), index)
+	var zero T
+	l.Elements = append(l.Elements, zero)
+	copy(l.Elements[index+1:], l.Elements[index:])
+	l.Elements[index] = element
+	return l
+}
+
+func (l *TypedList[T]) Remove(element T) error {
+	index := l.Index(element)
+	if index == -1 {
+		return notInList(element)
+	}
+	l.Elements = append(l.Elements[:index], l.Elements[index+1:]...)
+	return nil
+}
+
+func (l *TypedList[T]) Pop(index int) (T, error) {
+	var zero T
+	if len(l.Elements) == 0 {
+		return zero, popFromEmpty(index)
+	}
+
+	position := index
+	if position < 0 {
+		position = len(l.Elements) + position
+	}
+
+	if position < 0 || position >= len(l.Elements) {
+		return zero, indexOutOfRange(index)
+	}
+
+	element := l.Elements[position]
+	l.Elements = append(l.Elements[:position], l.Elements[position+1:]...)
+	return element, nil
+}
+
+func (l *TypedList[T]) Index(element T) int {
+	for i, e := range l.Elements {
+		if Equal(e, element) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (l *TypedList[T]) Count(element T) int {
+	count := 0
+	for _, e := range l.Elements {
+		if Equal(e, element) {
+			count++
+		}
+	}
+	return count
+}
+
+func (l *TypedList[T]) Reverse() *TypedList[T] {
+	for i, j := 0, len(l.Elements)-1; i < j; i, j = i+1, j-1 {
+		l.Elements[i], l.Elements[j] = l.Elements[j], l.Elements[i]
+	}
+	return l
+}
+
+func (l *TypedList[T]) Slice(start, end int) *TypedList[T] {
+	start, end = sliceBounds(len(l.Elements), start, end)
+	return &TypedList[T]{Elements: append([]T{}, l.Elements[start:end]...)}
+}
+
+func (l *TypedList[T]) Copy() *TypedList[T] {
+	newElements := make([]T, len(l.Elements))
+	copy(newElements, l.Elements)
+	return &TypedList[T]{Elements: newElements}
+}
+
+func (l *TypedList[T]) Len() int {
+	return len(l.Elements)
+}
+
+func (l *TypedList[T]) Clear() *TypedList[T] {
+	l.Elements = []T{}
+	return l
+}
+
+func (l *TypedList[T]) String() string {
+	if reprStrings.Load() {
+		return Repr(l)
+	}
+	strElems := make([]string, len(l.Elements))
+	for i, e := range l.Elements {
+		strElems[i] = fmt.Sprintf("%v", e)
+	}
+	return "[" + strings.Join(strElems, ", ") + "]"
+}
+
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
+
+// convert asserts v to T. nil, which stands for Python's None, converts to
+// the zero value of any T that can be nil, such as a pointer or a slice.
+func convert[T any](v interface{}) (T, bool) {
+	t, ok := v.(T)
+	if ok || v != nil {
+		return t, ok
+	}
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice,
+		reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return t, true
+	}
+	return t, false
+}
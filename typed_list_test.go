@@ -0,0 +1,141 @@
+package ezarr
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// Test | NewTypedList verifies the creation of a typed list with initial elements
+func TestNewTypedList(t *testing.T) {
+	list := NewTypedList(1, 2, 3)
+	if list.Len() != 3 {
+		t.Errorf("Expected length 3, got %d", list.Len())
+	}
+
+	if !reflect.DeepEqual(list.Elements, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", list.Elements)
+	}
+}
+
+// Test | TypedList Append, Extend and Insert verify adding elements, including with negative indices
+func TestTypedListInsert(t *testing.T) {
+	list := NewTypedList("b")
+	list.Append("d").Insert(0, "a").Insert(-1, "c")
+	list.Extend(NewTypedList("e"))
+	list.Insert(100, "f")
+
+	expected := []string{"a", "b", "c", "d", "e", "f"}
+	if !reflect.DeepEqual(list.Elements, expected) {
+		t.Errorf("Expected %v, got %v", expected, list.Elements)
+	}
+}
+
+// Test | TypedList Pop verifies removing and returning elements at specific indices
+func TestTypedListPop(t *testing.T) {
+	list := NewTypedList(1, 2, 3)
+
+	val, err := list.Pop(-1)
+	if err != nil || val != 3 {
+		t.Errorf("Expected popped value 3, got %v, error: %v", val, err)
+	}
+
+	val, err = list.Pop(0)
+	if err != nil || val != 1 {
+		t.Errorf("Expected popped value 1, got %v, error: %v", val, err)
+	}
+
+	_, err = list.Pop(5)
+	if err == nil {
+		t.Error("Expected error when popping with invalid index, got nil")
+	}
+
+	list.Clear()
+	_, err = list.Pop(0)
+	if err == nil {
+		t.Error("Expected error when popping from empty list, got nil")
+	}
+}
+
+// Test | TypedList Index, Count and Remove verify searching for elements
+func TestTypedListIndexCount(t *testing.T) {
+	list := NewTypedList([]int{1}, []int{2}, []int{1})
+
+	if index := list.Index([]int{2}); index != 1 {
+		t.Errorf("Expected index 1, got %d", index)
+	}
+	if index := list.Index([]int{3}); index != -1 {
+		t.Errorf("Expected index -1, got %d", index)
+	}
+	if count := list.Count([]int{1}); count != 2 {
+		t.Errorf("Expected count 2, got %d", count)
+	}
+
+	if err := list.Remove([]int{1}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if list.Len() != 2 || list.Index([]int{1}) != 1 {
+		t.Errorf("Expected first occurrence to be removed, got %v", list)
+	}
+	if err := list.Remove([]int{3}); err == nil {
+		t.Error("Expected error when removing non-existent element, got nil")
+	}
+}
+
+// Test | TypedList Slice, Reverse and Copy verify producing independent lists
+func TestTypedListSliceCopy(t *testing.T) {
+	list := NewTypedList(0, 1, 2, 3, 4)
+
+	if slice := list.Slice(-3, -1); !reflect.DeepEqual(slice.Elements, []int{2, 3}) {
+		t.Errorf("Expected [2 3], got %v", slice.Elements)
+	}
+
+	copied := list.Copy()
+	list.Reverse()
+	if !reflect.DeepEqual(list.Elements, []int{4, 3, 2, 1, 0}) {
+		t.Errorf("Expected reversed list, got %v", list.Elements)
+	}
+	if !reflect.DeepEqual(copied.Elements, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("Copy should be unaffected by Reverse, got %v", copied.Elements)
+	}
+
+	if str := copied.String(); str != "[0, 1, 2, 3, 4]" {
+		t.Errorf("Expected string representation '[0, 1, 2, 3, 4]', got '%s'", str)
+	}
+}
+
+// Test | TypedListFrom and ToList verify converting between typed and untyped lists
+func TestTypedListConversion(t *testing.T) {
+	list := New(1, 2, 3)
+
+	typed, err := TypedListFrom[int](list)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(typed.Elements, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", typed.Elements)
+	}
+
+	back := typed.ToList()
+	if !reflect.DeepEqual(back.Elements, list.Elements) {
+		t.Errorf("Expected %v, got %v", list.Elements, back.Elements)
+	}
+
+	_, err = TypedListFrom[int](New(1, "two"))
+	if err == nil {
+		t.Error("Expected error when converting mixed list, got nil")
+	}
+
+	anyList, err := TypedListFrom[interface{}](New(1, "two", nil))
+	if err != nil || anyList.Len() != 3 {
+		t.Errorf("Expected conversion to interface{} to succeed, got %v, error: %v", anyList, err)
+	}
+
+	pointers, err := TypedListFrom[*point](New(&point{1, 2}, nil))
+	if err != nil || pointers.Elements[1] != nil {
+		t.Errorf("Expected nil to convert to a nil pointer, got %v, error: %v", pointers, err)
+	}
+	if _, err := TypedListFrom[int](New(1, nil)); !errors.Is(err, ErrType) {
+		t.Errorf("Expected TypeError converting nil to int, got %v", err)
+	}
+}